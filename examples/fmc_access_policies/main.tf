@@ -26,7 +26,7 @@ data "fmc_access_policies" "access_policy" {
     name = "FTD"
 }
 
-resource "fmc_access_policies" "access_policy" {
+resource "fmc_access_policy" "access_policy" {
     name = "Terraform Access Policy"
     default_action = "block" # Cannot have block with base IPS policy
     # default_action = "permit"
@@ -41,5 +41,5 @@ output "existing_fmc_access_policy" {
 }
 
 output "new_fmc_access_policy" {
-    value = fmc_access_policies.access_policy
+    value = fmc_access_policy.access_policy
 }
@@ -48,7 +48,7 @@ resource "fmc_url_objects" "dest_url" {
     description = "Testing ACR"
 }
 
-resource "fmc_access_policies" "access_policy" {
+resource "fmc_access_policy" "access_policy" {
     name = "Terraform Access Policy"
     # default_action = "block" # Cannot have block with base IPS policy
     default_action = "permit"
@@ -59,7 +59,7 @@ resource "fmc_access_policies" "access_policy" {
 }
 
 resource "fmc_access_rules" "access_rule_1" {
-    acp = fmc_access_policies.access_policy.id
+    acp = fmc_access_policy.access_policy.id
     section = "mandatory"
     name = "Test rule 1"
     action = "allow"
@@ -115,7 +115,7 @@ resource "fmc_access_rules" "access_rule_1" {
 }
 
 resource "fmc_access_rules" "access_rule_2" {
-    acp = fmc_access_policies.access_policy.id
+    acp = fmc_access_policy.access_policy.id
     section = "mandatory"
     insert_before = 1 # Wont work as assumed since terraform does not 
     name = "Test rule 2"
@@ -175,7 +175,7 @@ resource "fmc_access_rules" "access_rule_2" {
 }
 
 resource "fmc_access_rules" "access_rule_3" {
-    acp = fmc_access_policies.access_policy.id
+    acp = fmc_access_policy.access_policy.id
     section = "mandatory"
     insert_before = 2 # Wont work as assumed since terraform does not 
     name = "Test rule 3"
@@ -225,6 +225,13 @@ resource "fmc_access_rules" "access_rule_3" {
             id = fmc_url_objects.dest_url.id
             type = "Url"
         }
+        literal = [ "example.com" ]
+    }
+    vlan_tags {
+        literal {
+            start_tag = 10
+            end_tag = 20
+        }
     }
     ips_policy = data.fmc_ips_policies.ips_policy.id
     syslog_config = data.fmc_syslog_alerts.syslog_alert.id
@@ -235,7 +242,7 @@ resource "fmc_access_rules" "access_rule_3" {
 }
 
 output "new_fmc_access_policy" {
-    value = fmc_access_policies.access_policy
+    value = fmc_access_policy.access_policy
 }
 
 output "new_fmc_access_rule_1" {
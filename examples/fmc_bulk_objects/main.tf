@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+resource "fmc_bulk_objects" "web_servers" {
+  type = "Host"
+  objects {
+    name  = "web-1"
+    value = "10.10.10.11"
+  }
+  objects {
+    name  = "web-2"
+    value = "10.10.10.12"
+  }
+}
+
+resource "fmc_network_group_objects" "web_servers" {
+  name = "web-servers"
+  dynamic "objects" {
+    for_each = fmc_bulk_objects.web_servers.ids
+    content {
+      id   = objects.value
+      type = fmc_bulk_objects.web_servers.type
+    }
+  }
+}
+
+output "web_server_ids" {
+  value = fmc_bulk_objects.web_servers.ids
+}
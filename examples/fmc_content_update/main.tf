@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+resource "fmc_content_update" "vdb" {
+  update_type = "VDB"
+  triggers = {
+    window = var.maintenance_window
+  }
+}
+
+resource "fmc_content_update" "sru" {
+  update_type = "SRU"
+  triggers = {
+    window = var.maintenance_window
+  }
+}
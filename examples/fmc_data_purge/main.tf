@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+resource "fmc_data_purge" "ci" {
+  name_prefix = "ci-${var.run_id}-"
+  object_types = ["NetworkGroup", "Network", "Host"]
+  confirm = true
+  triggers = {
+    run = var.run_id
+  }
+}
+
+output "deleted_objects" {
+  value = fmc_data_purge.ci.deleted_objects
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_device_clusters" "cluster" {
+  name = "ftd-cluster"
+}
+
+data "fmc_device_cluster_nodes" "nodes" {
+  cluster = data.fmc_device_clusters.cluster.id
+}
+
+output "control_node" {
+  value = data.fmc_device_clusters.cluster.control_node_name
+}
+
+output "healthy_data_nodes" {
+  value = [for node in data.fmc_device_cluster_nodes.nodes.nodes : node.name if node.role == "DATA" && node.health_status == "green"]
+}
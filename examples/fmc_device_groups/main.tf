@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "branch1" {
+    name = "branch1.adyah.cisco"
+}
+
+data "fmc_devices" "branch2" {
+    name = "branch2.adyah.cisco"
+}
+
+resource "fmc_device_groups" "branches" {
+    name = "Branches"
+    members {
+        id = data.fmc_devices.branch1.id
+        type = data.fmc_devices.branch1.type
+    }
+    members {
+        id = data.fmc_devices.branch2.id
+        type = data.fmc_devices.branch2.type
+    }
+}
+
+resource "fmc_access_policy" "branch_policy" {
+    name = "Terraform Branch Policy"
+    default_action = "block"
+}
+
+resource "fmc_policy_devices_assignments" "branches" {
+    policy {
+        id = fmc_access_policy.branch_policy.id
+        type = fmc_access_policy.branch_policy.type
+    }
+    target_device_groups = [fmc_device_groups.branches.id]
+}
+
+resource "fmc_staged_changes" "deploy" {
+    device_groups = [fmc_device_groups.branches.id]
+    depends_on = [fmc_policy_devices_assignments.branches]
+}
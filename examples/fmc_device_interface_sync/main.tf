@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "ftd" {
+  name = "FTD"
+}
+
+resource "fmc_device_interface_sync" "ftd" {
+  device = data.fmc_devices.ftd.id
+  triggers = {
+    nics = var.ftd_nic_count
+  }
+}
+
+data "fmc_device_physical_interfaces" "data" {
+  device = data.fmc_devices.ftd.id
+  name = "GigabitEthernet0/2"
+  depends_on = [fmc_device_interface_sync.ftd]
+}
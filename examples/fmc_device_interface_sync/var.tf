@@ -0,0 +1,22 @@
+variable "fmc_username" {
+    type = string
+    sensitive = true
+}
+
+variable "fmc_password" {
+    type = string
+    sensitive = true
+}
+
+variable "fmc_host" {
+    type = string
+}
+
+variable "fmc_insecure_skip_verify" {
+    type = bool
+    default = false
+}
+variable "ftd_nic_count" {
+    type = number
+    default = 4
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "ftd" {
+  name = "ftd.adyah.cisco"
+}
+
+resource "fmc_device_licenses" "ftd" {
+  device = data.fmc_devices.ftd.id
+  license_caps = ["BASE", "THREAT", "MALWARE", "URLFilter"]
+}
@@ -0,0 +1,61 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "ftd" {
+  name = "ftd.adyah.cisco"
+}
+
+data "fmc_device_physical_interfaces" "inside" {
+  device = data.fmc_devices.ftd.id
+  name = "GigabitEthernet0/1"
+}
+
+data "fmc_device_physical_interfaces" "isp1" {
+  device = data.fmc_devices.ftd.id
+  name = "GigabitEthernet0/0"
+}
+
+data "fmc_device_physical_interfaces" "isp2" {
+  device = data.fmc_devices.ftd.id
+  name = "GigabitEthernet0/2"
+}
+
+data "fmc_extended_access_lists" "pbr_acl" {
+  name = "PBR-ACL"
+}
+
+resource "fmc_device_policy_based_routes" "pbr" {
+  device = data.fmc_devices.ftd.id
+  ingress_interfaces {
+    id = data.fmc_device_physical_interfaces.inside.id
+    type = data.fmc_device_physical_interfaces.inside.type
+  }
+  forwarding_actions {
+    match_acl {
+      id = data.fmc_extended_access_lists.pbr_acl.id
+      type = data.fmc_extended_access_lists.pbr_acl.type
+    }
+    egress_interfaces {
+      id = data.fmc_device_physical_interfaces.isp1.id
+      type = data.fmc_device_physical_interfaces.isp1.type
+    }
+    egress_interfaces {
+      id = data.fmc_device_physical_interfaces.isp2.id
+      type = data.fmc_device_physical_interfaces.isp2.type
+    }
+    interface_ordering = "ORDER"
+  }
+}
\ No newline at end of file
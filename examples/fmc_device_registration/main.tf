@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+variable "ftd_instances" {
+  description = "FTDv instances of the auto-scale group, keyed by instance name"
+  type = map(object({
+    host_name     = string
+    serial_number = string
+  }))
+  default = {}
+}
+
+variable "ftd_reg_key" {
+  type      = string
+  sensitive = true
+}
+
+data "fmc_access_policies" "access_policy" {
+  name = "FTD"
+}
+
+resource "fmc_device_registration" "asg" {
+  for_each         = var.ftd_instances
+  name             = each.key
+  host_name        = each.value.host_name
+  serial_number    = each.value.serial_number
+  reg_key          = var.ftd_reg_key
+  license_caps     = ["BASE", "THREAT"]
+  performance_tier = "FTDv30"
+  access_policy    = data.fmc_access_policies.access_policy.id
+}
+
+output "registered_devices" {
+  value = { for name, device in fmc_device_registration.asg : name => device.id }
+}
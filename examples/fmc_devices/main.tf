@@ -20,4 +20,24 @@ data "fmc_devices" "device" {
 
 output "existing_device" {
     value = data.fmc_devices.device
-}
\ No newline at end of file
+}
+
+data "fmc_access_policies" "access_policy" {
+    name = "FTD"
+}
+
+resource "fmc_devices" "ftdv" {
+    name = "ftdv-cloud-1"
+    host_name = var.ftd_host
+    reg_key = var.ftd_reg_key
+    license_caps = ["BASE", "THREAT"]
+    performance_tier = "FTDv30"
+    access_policy = data.fmc_access_policies.access_policy.id
+    timeouts {
+        create = "45m"
+    }
+}
+
+output "registered_device" {
+    value = fmc_devices.ftdv
+}
@@ -15,4 +15,13 @@ variable "fmc_host" {
 variable "fmc_insecure_skip_verify" {
     type = bool
     default = false
-}
\ No newline at end of file
+}
+
+variable "ftd_host" {
+    type = string
+}
+
+variable "ftd_reg_key" {
+    type = string
+    sensitive = true
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+resource "fmc_dns_server_groups" "corporate" {
+  name = "Corporate DNS"
+  servers = ["10.10.10.53", "10.10.20.53"]
+  default_domain = "example.com"
+  retries = 3
+  timeout = 5
+}
@@ -0,0 +1,54 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "ftd" {
+  name = "ftd.adyah.cisco"
+}
+
+resource "fmc_text_objects" "ecmp_zone" {
+  name = "ecmp_zone_name"
+  description = "ECMP zone for the outside interfaces"
+  variable_type = "MULTIPLE"
+  values = ["outside-ecmp"]
+}
+
+resource "fmc_flexconfig_objects" "ecmp" {
+  name = "ECMP_Zone"
+  description = "ECMP zone for the outside interfaces"
+  deploy = "EVERYTIME"
+  object_type = "APPEND"
+  content = "zone $ecmp_zone_name ecmp"
+}
+
+resource "fmc_flexconfig_policies" "flexconfig" {
+  name = "FTD FlexConfig"
+  description = "FlexConfig policy for the FTD devices"
+  append_flexconfigs {
+    id = fmc_flexconfig_objects.ecmp.id
+    type = fmc_flexconfig_objects.ecmp.type
+  }
+}
+
+resource "fmc_policy_devices_assignments" "flexconfig" {
+  policy {
+    id = fmc_flexconfig_policies.flexconfig.id
+    type = fmc_flexconfig_policies.flexconfig.type
+  }
+  target_devices {
+    id = data.fmc_devices.ftd.id
+    type = data.fmc_devices.ftd.type
+  }
+}
\ No newline at end of file
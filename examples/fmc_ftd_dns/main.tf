@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_ftd_platform_settings_policies" "platform_settings" {
+  name = "FTD Platform Settings"
+}
+
+data "fmc_dns_server_groups" "default" {
+  name = "CiscoUmbrellaDNSServerGroup"
+}
+
+data "fmc_security_zones" "inside" {
+  name = "inside"
+}
+
+resource "fmc_ftd_dns" "dns" {
+  platform_settings = data.fmc_ftd_platform_settings_policies.platform_settings.id
+  dns_server_group = data.fmc_dns_server_groups.default.id
+  interfaces {
+    id = data.fmc_security_zones.inside.id
+    type = data.fmc_security_zones.inside.type
+  }
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_ftd_s2s_vpns" "branches" {
+  name = "Branches"
+}
+
+resource "fmc_ftd_s2s_vpn_psk" "branches" {
+  vpn = data.fmc_ftd_s2s_vpns.branches.id
+  ike_version = "IKEV2"
+  pre_shared_key = var.branches_psk
+  psk_rotation_trigger = "2024-Q1"
+}
@@ -0,0 +1,56 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_ftd_platform_settings_policies" "platform_settings" {
+  name = "FTD Platform Settings"
+}
+
+data "fmc_security_zones" "inside" {
+  name = "inside"
+}
+
+resource "fmc_host_objects" "nms" {
+  name = "NMS"
+  value = "10.10.20.5"
+}
+
+resource "fmc_ftd_snmp" "snmp" {
+  platform_settings = data.fmc_ftd_platform_settings_policies.platform_settings.id
+  location = "DC1"
+  contact = "noc@example.com"
+  users {
+    username = "monitoring"
+    security_level = "PRIV"
+    auth_algorithm = "SHA256"
+    auth_password = var.snmp_auth_password
+    encryption_algorithm = "AES256"
+    encryption_password = var.snmp_encryption_password
+  }
+  hosts {
+    ip_address {
+      id = fmc_host_objects.nms.id
+      type = fmc_host_objects.nms.type
+    }
+    interfaces {
+      id = data.fmc_security_zones.inside.id
+      type = data.fmc_security_zones.inside.type
+    }
+    username = "monitoring"
+    trap = true
+  }
+  trap_link_up = true
+  trap_link_down = true
+}
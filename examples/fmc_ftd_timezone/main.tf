@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_ftd_platform_settings_policies" "platform_settings" {
+  name = "FTD Platform Settings"
+}
+
+resource "fmc_timezone_objects" "berlin" {
+  name = "Berlin"
+  description = "Timezone of the branch offices"
+  timezone = "Europe/Berlin"
+}
+
+resource "fmc_ftd_timezone" "timezone" {
+  platform_settings = data.fmc_ftd_platform_settings_policies.platform_settings.id
+  timezone_object = fmc_timezone_objects.berlin.id
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+provider "fmc" {
+  alias = "branch"
+  fmc_username = var.branch_fmc_username
+  fmc_password = var.branch_fmc_password
+  fmc_host = var.branch_fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+resource "fmc_object_sync" "branch" {
+  provider = fmc.branch
+  source {
+    fmc_host = var.fmc_host
+    fmc_username = var.fmc_username
+    fmc_password = var.fmc_password
+    fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+  }
+  network_objects = ["VLAN825-Private"]
+  host_objects = ["CUCM-Pub"]
+  fqdn_objects = ["Office365"]
+}
+
+output "synced_objects" {
+  value = fmc_object_sync.branch.objects
+}
\ No newline at end of file
@@ -0,0 +1,32 @@
+variable "fmc_username" {
+    type = string
+    sensitive = true
+}
+
+variable "fmc_password" {
+    type = string
+    sensitive = true
+}
+
+variable "fmc_host" {
+    type = string
+}
+
+variable "fmc_insecure_skip_verify" {
+    type = bool
+    default = false
+}
+
+variable "branch_fmc_username" {
+    type = string
+    sensitive = true
+}
+
+variable "branch_fmc_password" {
+    type = string
+    sensitive = true
+}
+
+variable "branch_fmc_host" {
+    type = string
+}
\ No newline at end of file
@@ -26,7 +26,7 @@ data "fmc_devices" "device" {
     name = "ftd.adyah.cisco"
 }
 
-resource "fmc_access_policies" "access_policy" {
+resource "fmc_access_policy" "access_policy" {
     name = "Terraform Access Policy Assignment"
     # default_action = "block" # Cannot have block with base IPS policy
     default_action = "permit"
@@ -38,8 +38,8 @@ resource "fmc_access_policies" "access_policy" {
 
 resource "fmc_policy_devices_assignments" "policy_assignment" {
     policy {
-        id = fmc_access_policies.access_policy.id
-        type = fmc_access_policies.access_policy.type
+        id = fmc_access_policy.access_policy.id
+        type = fmc_access_policy.access_policy.type
     }
     target_devices {
         id = data.fmc_devices.device.id
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "ftd1" {
+  name = "FTD1"
+}
+
+data "fmc_devices" "ftd2" {
+  name = "FTD2"
+}
+
+resource "fmc_access_policies" "access_policy" {
+  name = "Terraform Access Policy"
+  default_action = "block"
+}
+
+resource "fmc_staged_changes" "deploy" {
+  devices = [data.fmc_devices.ftd1.id, data.fmc_devices.ftd2.id]
+  allow_traffic_interruption = false
+  depends_on = [fmc_access_policies.access_policy]
+}
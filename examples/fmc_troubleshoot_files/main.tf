@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "ftd" {
+  name = "FTD"
+}
+
+resource "fmc_troubleshoot_files" "ftd" {
+  device = data.fmc_devices.ftd.id
+  output_path = "${path.module}/troubleshoot/ftd.tar.gz"
+  triggers = {
+    incident = var.incident_id
+  }
+}
@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    fmc = {
+      source = "CiscoDevNet/fmc"
+      version = "0.2"
+    }
+  }
+}
+
+provider "fmc" {
+  fmc_username = var.fmc_username
+  fmc_password = var.fmc_password
+  fmc_host = var.fmc_host
+  fmc_insecure_skip_verify = var.fmc_insecure_skip_verify
+}
+
+data "fmc_devices" "device" {
+  name = "ftd.adyah.cisco"
+}
+
+data "fmc_device_physical_interfaces" "outside" {
+  device = data.fmc_devices.device.id
+  name   = "GigabitEthernet0/0"
+}
+
+data "fmc_security_zones" "inside" {
+  name = "inside"
+}
+
+resource "fmc_vtep_policies" "vtep" {
+  device = data.fmc_devices.device.id
+  vtep_entry {
+    source_interface {
+      id   = data.fmc_device_physical_interfaces.outside.id
+      type = data.fmc_device_physical_interfaces.outside.type
+    }
+    nve_encapsulation_type = "GENEVE"
+  }
+}
+
+resource "fmc_vni_interfaces" "vni" {
+  device       = data.fmc_devices.device.id
+  vni_id       = 1
+  vtep_id      = fmc_vtep_policies.vtep.vtep_entry[0].nve_vtep_id
+  ifname       = "vni1"
+  enable_proxy = true
+  security_zone {
+    id   = data.fmc_security_zones.inside.id
+    type = data.fmc_security_zones.inside.type
+  }
+}
+
+output "vni_interface" {
+  value = fmc_vni_interfaces.vni
+}
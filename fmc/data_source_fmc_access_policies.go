@@ -2,6 +2,9 @@ package fmc
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +18,12 @@ func dataSourceFmcAccessPolicies() *schema.Resource {
 			"data \"fmc_access_policies\" \"acp\" {\n" +
 			"	name = \"FTD ACP\"\n" +
 			"}\n" +
-			"```",
+			"\n" +
+			"data \"fmc_access_policies\" \"sites\" {\n" +
+			"	name_regex = \"^site-[0-9]+-acp$\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** With `name_regex` all the matching policies are returned in `policies`, sorted by name, e.g. to fan out a module per site with `for_each`.",
 		ReadContext: dataSourceFmcAccessPoliciesRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -24,25 +32,71 @@ func dataSourceFmcAccessPolicies() *schema.Resource {
 				Description: "The ID of this resource",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Name of the FTD accessPolicy",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "name_regex"},
+				Description:  "Name of the FTD accessPolicy",
+			},
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if _, err := regexp.Compile(val.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q must be a regular expression: %s", key, err.Error()))
+					}
+					return
+				},
+				Description: "Regular expression matching the names of the FTD accessPolicies, instead of name",
 			},
 			"type": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Type of this resource",
 			},
+			"default_action_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the default action of the FTD accessPolicy, e.g. for syslog overrides of the default action",
+			},
+			"default_action": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The action of the default action of the FTD accessPolicy",
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the accessPolicy",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the accessPolicy",
+						},
+					},
+				},
+				Description: "The accessPolicies whose names match name_regex, sorted by name",
+			},
 		},
 	}
 }
 
 func dataSourceFmcAccessPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if _, ok := d.GetOk("name_regex"); ok {
+		return dataSourceFmcAccessPoliciesReadByRegex(ctx, d, m)
+	}
+
 	c := m.(*Client)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
-	accessPolicy, err := c.GetFmcAccessPolicyByName(ctx, d.Get("name").(string))
+	accessPolicy, err := c.GetFmcAccessPolicyByName(ctx, c.mappedName("access_policy", d.Get("name").(string)))
 
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -73,5 +127,87 @@ func dataSourceFmcAccessPoliciesRead(ctx context.Context, d *schema.ResourceData
 		return diags
 	}
 
+	if err := d.Set("default_action_id", accessPolicy.Defaultaction.ID); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read accessPolicy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("default_action", accessPolicy.Defaultaction.Action); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read accessPolicy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	policies := []interface{}{map[string]interface{}{
+		"id":   accessPolicy.ID,
+		"name": accessPolicy.Name,
+	}}
+	if err := d.Set("policies", policies); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read accessPolicy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func dataSourceFmcAccessPoliciesReadByRegex(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	nameRegex := d.Get("name_regex").(string)
+	// Already validated
+	re := regexp.MustCompile(nameRegex)
+
+	accessPolicies, err := c.GetFmcAccessPolicies(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get accessPolicies",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	items := accessPolicies.Items[:0]
+	for _, item := range accessPolicies.Items {
+		if re.MatchString(item.Name) {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	policies := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		policies = append(policies, map[string]interface{}{
+			"id":   item.ID,
+			"name": item.Name,
+		})
+	}
+
+	d.SetId(nameRegex)
+
+	if err := d.Set("policies", policies); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read accessPolicies",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	return diags
 }
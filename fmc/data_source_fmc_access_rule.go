@@ -0,0 +1,106 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcAccessRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for an Access Rule of an Access Policy in FMC, by name\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_access_rule\" \"legacy\" {\n" +
+			"	acp = data.fmc_access_policies.acp.id\n" +
+			"	name = \"Legacy allow\"\n" +
+			"}\n" +
+			"\n" +
+			"resource \"fmc_access_rules\" \"before_legacy\" {\n" +
+			"	acp = data.fmc_access_policies.acp.id\n" +
+			"	name = \"Block before legacy\"\n" +
+			"	action = \"block\"\n" +
+			"	enabled = true\n" +
+			"	insert_before = data.fmc_access_rule.legacy.rule_index\n" +
+			"}\n" +
+			"```",
+		ReadContext: dataSourceFmcAccessRuleRead,
+		Schema: map[string]*schema.Schema{
+			"acp": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the access policy",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the rule",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The action of the rule",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rule is enabled",
+			},
+			"rule_index": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The position of the rule in the access policy, starting at 1",
+			},
+			"section": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The section of the rule, "mandatory" or "default"`,
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The category of the rule",
+			},
+		},
+	}
+}
+
+func dataSourceFmcAccessRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	rule, err := c.GetFmcAccessRuleByName(ctx, d.Get("acp").(string), d.Get("name").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get access rule",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(rule.ID)
+
+	for key, value := range flattenAccessRuleSummary(rule) {
+		if key == "id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access rule",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,122 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcAccessRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the Access Rules of an Access Policy in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_access_rules\" \"existing\" {\n" +
+			"	acp = data.fmc_access_policies.acp.id\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The rules are listed in the order of their `rule_index`, including the rules created outside of terraform, " +
+			"e.g. to place new rules with `insert_before` or `insert_after` relative to them.",
+		ReadContext: dataSourceFmcAccessRulesRead,
+		Schema: map[string]*schema.Schema{
+			"acp": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the access policy",
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action of the rule",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the rule is enabled",
+						},
+						"rule_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The position of the rule in the access policy, starting at 1",
+						},
+						"section": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The section of the rule, "mandatory" or "default"`,
+						},
+						"category": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The category of the rule",
+						},
+					},
+				},
+				Description: "The rules of the access policy, in the order of their index",
+			},
+		},
+	}
+}
+
+// flattenAccessRuleSummary returns the position and the main settings of the rule
+func flattenAccessRuleSummary(rule *AccessRuleResponse) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         rule.ID,
+		"name":       rule.Name,
+		"action":     rule.Action,
+		"enabled":    rule.Enabled,
+		"rule_index": rule.Metadata.Ruleindex,
+		"section":    rule.Metadata.Section,
+		"category":   rule.Metadata.Category,
+	}
+}
+
+func dataSourceFmcAccessRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	acpId := d.Get("acp").(string)
+	items, err := c.GetFmcAccessRules(ctx, acpId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get access rules",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	rules := make([]interface{}, 0, len(items))
+	for i := range items {
+		rules = append(rules, flattenAccessRuleSummary(&items[i]))
+	}
+
+	d.SetId(acpId)
+
+	if err := d.Set("rules", rules); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read access rules",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,85 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcAnyConnectCustomAttributes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for AnyConnect custom attributes in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_anyconnect_custom_attributes\" \"defer_update\" {\n" +
+			"	name = \"DeferUpdate\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The FMC API does not support creating AnyConnect custom attributes, they have to be created in the FMC UI.",
+		ReadContext: dataSourceFmcAnyConnectCustomAttributesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this resource",
+			},
+			"attribute_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the attribute, e.g. "DEFER_UPDATE" or "USER_DEFINED"`,
+			},
+		},
+	}
+}
+
+func dataSourceFmcAnyConnectCustomAttributesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	attribute, err := c.GetFmcAnyConnectCustomAttributeByName(ctx, d.Get("name").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get anyconnect custom attribute",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(attribute.ID)
+
+	for key, value := range map[string]interface{}{
+		"type":           attribute.Type,
+		"description":    attribute.Description,
+		"attribute_type": attribute.Attributetype,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read anyconnect custom attribute",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
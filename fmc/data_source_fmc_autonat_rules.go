@@ -0,0 +1,167 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// autoNatRuleReferenceSchema is the computed schema of an interface or network referenced by an auto nat rule
+func autoNatRuleReferenceSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of this resource",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of this resource",
+				},
+			},
+		},
+		Description: description,
+	}
+}
+
+func dataSourceFmcAutoNatRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for an existing Auto NAT Rule of a NAT policy in FMC, by description or index\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_ftd_autonat_rules\" \"dns\" {\n" +
+			"	nat_policy = fmc_ftd_nat_policies.nat_policy.id\n" +
+			"	description = \"DNS server\"\n" +
+			"}\n" +
+			"\n" +
+			"data \"fmc_ftd_autonat_rules\" \"first\" {\n" +
+			"	nat_policy = fmc_ftd_nat_policies.nat_policy.id\n" +
+			"	index = 1\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The index is the position of the rule among the auto NAT rules of the policy, starting at 1. " +
+			"If several rules have the same description, the first one is returned.",
+		ReadContext: dataSourceFmcAutoNatRulesRead,
+		Schema: map[string]*schema.Schema{
+			"nat_policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the NAT policy",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"description", "index"},
+				Description:  "The description of the rule",
+			},
+			"index": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if v := val.(int); v < 1 {
+						errs = append(errs, fmt.Errorf("%q must be at least 1, got: %d", key, v))
+					}
+					return
+				},
+				Description: "The position of the rule among the auto NAT rules of the policy, starting at 1",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+			"nat_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the rule, "STATIC" or "DYNAMIC"`,
+			},
+			"source_interface":      autoNatRuleReferenceSchema("Source interface of the rule"),
+			"destination_interface": autoNatRuleReferenceSchema("Destination interface of the rule"),
+			"original_network":      autoNatRuleReferenceSchema("Original network of the rule"),
+			"translated_network":    autoNatRuleReferenceSchema("Translated network of the rule"),
+			"translated_network_is_destination_interface": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the destination interface is used as the translated network",
+			},
+			"ipv6": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rule uses the IPv6 address of the destination interface",
+			},
+		},
+	}
+}
+
+// flattenAutoNatRuleReference returns the reference as a list of one item, or an empty list if it is not set
+func flattenAutoNatRuleReference(item AutoNatRuleSubConfig) []interface{} {
+	if item == (AutoNatRuleSubConfig{}) {
+		return []interface{}{}
+	}
+	return convertTo1ListMapStringGeneric(item)
+}
+
+func dataSourceFmcAutoNatRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	natId := d.Get("nat_policy").(string)
+
+	var rule *AutoNatRuleResponse
+	var err error
+	index, ok := d.GetOk("index")
+	if ok {
+		rule, err = c.GetFmcAutoNatRuleByIndex(ctx, natId, index.(int))
+	} else {
+		rule, index, err = c.GetFmcAutoNatRuleByDescription(ctx, natId, d.Get("description").(string))
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get auto nat rule",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(rule.ID)
+
+	for key, value := range map[string]interface{}{
+		"description":           rule.Description,
+		"index":                 index,
+		"type":                  rule.Type,
+		"nat_type":              rule.Nattype,
+		"source_interface":      flattenAutoNatRuleReference(rule.Sourceinterface),
+		"destination_interface": flattenAutoNatRuleReference(rule.Destinationinterface),
+		"original_network":      flattenAutoNatRuleReference(rule.Originalnetwork),
+		"translated_network":    flattenAutoNatRuleReference(rule.Translatednetwork),
+		"translated_network_is_destination_interface": rule.Interfaceintranslatednetwork,
+		"ipv6": rule.Interfaceipv6,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read auto nat rule",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
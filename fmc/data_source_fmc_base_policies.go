@@ -0,0 +1,124 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Names of the system-provided base policies, which are the same for intrusion and network analysis policies
+var base_policy_names = map[string]string{
+	"balanced":      "Balanced Security and Connectivity",
+	"connectivity":  "Connectivity Over Security",
+	"security":      "Security Over Connectivity",
+	"max_detection": "Maximum Detection",
+	"no_rules":      "No Rules Active",
+}
+
+func dataSourceFmcBasePolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the system-provided base policies in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_base_policies\" \"defaults\" {\n" +
+			"}\n" +
+			"\n" +
+			"resource \"fmc_access_policy\" \"access_policy\" {\n" +
+			"	name = \"Terraform Access Policy\"\n" +
+			"	default_action = \"permit\"\n" +
+			"	default_action_base_intrusion_policy_id = data.fmc_base_policies.defaults.intrusion_policies[\"balanced\"]\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The policies are keyed by `balanced`, `connectivity`, `security`, `max_detection` and `no_rules`, " +
+			"base policies which are not available in the FMC version are omitted.",
+		ReadContext: dataSourceFmcBasePoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"intrusion_policies": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the system-provided intrusion policies in the domain",
+			},
+			"network_analysis_policies": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the system-provided network analysis policies in the domain",
+			},
+		},
+	}
+}
+
+// basePolicyIDs returns the IDs of the system-provided base policies, keyed as in base_policy_names
+func basePolicyIDs(names map[string]string) map[string]interface{} {
+	ids := map[string]interface{}{}
+	for key, name := range base_policy_names {
+		if id, ok := names[name]; ok {
+			ids[key] = id
+		}
+	}
+	return ids
+}
+
+func dataSourceFmcBasePoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	ipsPolicies, err := c.GetFmcIPSPolicies(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get base policies",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	networkAnalysisPolicies, err := c.GetFmcNetworkAnalysisPolicies(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get base policies",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ipsPolicyIDs := map[string]string{}
+	for _, policy := range ipsPolicies {
+		ipsPolicyIDs[policy.Name] = policy.ID
+	}
+	networkAnalysisPolicyIDs := map[string]string{}
+	for _, policy := range networkAnalysisPolicies {
+		networkAnalysisPolicyIDs[policy.Name] = policy.ID
+	}
+
+	// The base policies are provided per domain
+	d.SetId(c.domainUUID)
+
+	for key, value := range map[string]interface{}{
+		"intrusion_policies":        basePolicyIDs(ipsPolicyIDs),
+		"network_analysis_policies": basePolicyIDs(networkAnalysisPolicyIDs),
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read base policies",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
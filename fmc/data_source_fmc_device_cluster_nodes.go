@@ -0,0 +1,120 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcDeviceClusterNodes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the nodes of FTD Device Clusters in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_device_cluster_nodes\" \"nodes\" {\n" +
+			"	cluster = data.fmc_device_clusters.cluster.id\n" +
+			"}\n" +
+			"```\n" +
+			"The control node is always the first entry in `nodes`, so data nodes that are safe to remove during scale-in can be picked from the rest of the list.",
+		ReadContext: dataSourceFmcDeviceClusterNodesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the FTD device cluster",
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the node",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the node",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The role of the node in the cluster, "CONTROL" or "DATA"`,
+						},
+						"health_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health status of the node as reported by FMC",
+						},
+						"sw_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The software version of the node",
+						},
+					},
+				},
+				Description: "The nodes of the cluster",
+			},
+		},
+	}
+}
+
+func dataSourceFmcDeviceClusterNodesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	cluster, err := c.GetFmcDeviceCluster(ctx, d.Get("cluster").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get device cluster",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(cluster.ID)
+
+	roles := []string{"CONTROL"}
+	members := []DeviceClusterNode{cluster.Controldevice}
+	for _, node := range cluster.Datadevices {
+		roles = append(roles, "DATA")
+		members = append(members, node)
+	}
+
+	nodes := make([]interface{}, 0, len(members))
+	for i, member := range members {
+		device, err := c.GetFmcDevice(ctx, member.Devicedetails.ID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get device cluster node",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		nodes = append(nodes, map[string]interface{}{
+			"id":            device.ID,
+			"name":          device.Name,
+			"role":          roles[i],
+			"health_status": device.Healthstatus,
+			"sw_version":    device.Sw_version,
+		})
+	}
+
+	if err := d.Set("nodes", nodes); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device cluster nodes",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,99 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcDeviceClusters() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for FTD Device Clusters in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_device_clusters\" \"cluster\" {\n" +
+			"	name = \"ftd-cluster\"\n" +
+			"}\n" +
+			"```",
+		ReadContext: dataSourceFmcDeviceClustersRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the FTD device cluster",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of this resource",
+			},
+			"control_node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the control node of the cluster",
+			},
+			"control_node_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the control node of the cluster",
+			},
+			"data_node_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the data nodes of the cluster",
+			},
+		},
+	}
+}
+
+func dataSourceFmcDeviceClustersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	cluster, err := c.GetFmcDeviceClusterByName(ctx, d.Get("name").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get device cluster",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(cluster.ID)
+
+	dataNodeIDs := make([]string, 0, len(cluster.Datadevices))
+	for _, node := range cluster.Datadevices {
+		dataNodeIDs = append(dataNodeIDs, node.Devicedetails.ID)
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":              cluster.Name,
+		"type":              cluster.Type,
+		"control_node_id":   cluster.Controldevice.Devicedetails.ID,
+		"control_node_name": cluster.Controldevice.Devicedetails.Name,
+		"data_node_ids":     dataNodeIDs,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read device cluster",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
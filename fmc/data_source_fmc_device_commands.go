@@ -0,0 +1,98 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The device command API was added in FMC 7.1
+var device_command_minimum_version string = "7.1"
+
+func dataSourceFmcDeviceCommands() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for running show commands on FTD devices in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_device_commands\" \"failover\" {\n" +
+			"	device = data.fmc_devices.ftd.id\n" +
+			"	command = \"show failover history\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The device command API needs FMC 7.1+, which only allows a list of show commands.",
+		ReadContext: dataSourceFmcDeviceCommandsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the FTD device",
+			},
+			"command": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.Fields(val.(string))
+					if len(v) > 0 && strings.EqualFold(v[0], "show") {
+						return
+					}
+					errs = append(errs, fmt.Errorf("%q must be a show command, got: %q", key, val.(string)))
+					return
+				},
+				Description: `The show command to run, e.g. "show version"`,
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The output of the command",
+			},
+		},
+	}
+}
+
+func dataSourceFmcDeviceCommandsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	if err := c.requireFmcVersion(ctx, "fmc_device_commands", device_command_minimum_version); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to run device command",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	deviceID := d.Get("device").(string)
+	command := d.Get("command").(string)
+	output, err := c.GetFmcDeviceCommandOutput(ctx, deviceID, command)
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to run device command",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", deviceID, strings.Join(strings.Fields(command), " ")))
+
+	if err := d.Set("output", output); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device command",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,133 @@
+package fmc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcDeviceHAPairs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for FTD Device HA Pairs in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_device_ha_pairs\" \"ha\" {\n" +
+			"	name = \"ftd-ha\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The failover history is not available in the HA pair API, " +
+			"run `show failover history` with `fmc_device_commands` to find the reason of the last failover.",
+		ReadContext: dataSourceFmcDeviceHAPairsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the FTD device HA pair",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of this resource",
+			},
+			"primary_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the primary device of the HA pair",
+			},
+			"primary_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the primary device of the HA pair",
+			},
+			"primary_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current HA role of the primary device, e.g. "Active" or "Standby"`,
+			},
+			"secondary_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secondary device of the HA pair",
+			},
+			"secondary_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the secondary device of the HA pair",
+			},
+			"secondary_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current HA role of the secondary device, e.g. "Active" or "Standby"`,
+			},
+			"active_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the active device of the HA pair, empty if neither device is active",
+			},
+			"failed_over": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secondary device is active, i.e. the HA pair failed over",
+			},
+		},
+	}
+}
+
+func dataSourceFmcDeviceHAPairsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	haPair, err := c.GetFmcDeviceHAPairByName(ctx, d.Get("name").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get device HA pair",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(haPair.ID)
+
+	activeID := ""
+	failedOver := false
+	if strings.EqualFold(haPair.Metadata.Primarystatus.Currentstatus, "active") {
+		activeID = haPair.Primary.ID
+	} else if strings.EqualFold(haPair.Metadata.Secondarystatus.Currentstatus, "active") {
+		activeID = haPair.Secondary.ID
+		failedOver = true
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":             haPair.Name,
+		"type":             haPair.Type,
+		"primary_id":       haPair.Primary.ID,
+		"primary_name":     haPair.Primary.Name,
+		"primary_status":   haPair.Metadata.Primarystatus.Currentstatus,
+		"secondary_id":     haPair.Secondary.ID,
+		"secondary_name":   haPair.Secondary.Name,
+		"secondary_status": haPair.Metadata.Secondarystatus.Currentstatus,
+		"active_id":        activeID,
+		"failed_over":      failedOver,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read device HA pair",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,89 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcDevicePhysicalInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Physical Interfaces of FTD Devices in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_device_physical_interfaces\" \"outside\" {\n" +
+			"	device = data.fmc_devices.device.id\n" +
+			"	name = \"GigabitEthernet0/0\"\n" +
+			"}\n" +
+			"```\n" +
+			"The name can be either the hardware name or the logical name of the interface.",
+		ReadContext: dataSourceFmcDevicePhysicalInterfacesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the FTD device",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"ifname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The logical name of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func dataSourceFmcDevicePhysicalInterfacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	physicalInterface, err := c.GetFmcPhysicalInterfaceByName(ctx, d.Get("device").(string), d.Get("name").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get physical interface",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(physicalInterface.ID)
+
+	if err := d.Set("ifname", physicalInterface.Ifname); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read physical interface",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("type", physicalInterface.Type); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read physical interface",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -42,7 +42,7 @@ func dataSourceFmcDevicesRead(ctx context.Context, d *schema.ResourceData, m int
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
-	device, err := c.GetFmcDeviceByName(ctx, d.Get("name").(string))
+	device, err := c.GetFmcDeviceByName(ctx, c.mappedName("device", d.Get("name").(string)))
 
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
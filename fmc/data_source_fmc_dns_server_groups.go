@@ -0,0 +1,96 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcDNSServerGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for DNS Server Group Objects in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_dns_server_groups\" \"default\" {\n" +
+			"	name = \"CiscoUmbrellaDNSServerGroup\"\n" +
+			"}\n" +
+			"```",
+		ReadContext: dataSourceFmcDNSServerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The addresses of the DNS servers of this resource",
+			},
+			"default_domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default domain of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+// flattenDNSServerGroupServers returns the addresses of the DNS servers of the group
+func flattenDNSServerGroupServers(servers []DNSServerGroupServer) []interface{} {
+	addresses := make([]interface{}, 0, len(servers))
+	for _, server := range servers {
+		addresses = append(addresses, server.Nameserver)
+	}
+	return addresses
+}
+
+func dataSourceFmcDNSServerGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	item, err := c.GetFmcDNSServerGroupObjectByName(ctx, c.mappedName("dns_server_group", d.Get("name").(string)))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get dns server group object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(item.ID)
+
+	for key, value := range map[string]interface{}{
+		"name":           item.Name,
+		"servers":        flattenDNSServerGroupServers(item.Dnsservers),
+		"default_domain": item.Defaultdomain,
+		"type":           item.Type,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read dns server group object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
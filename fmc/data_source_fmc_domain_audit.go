@@ -0,0 +1,208 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcDomainAudit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the last changes of all the policies and objects of the domain in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_domain_audit\" \"drift\" {\n" +
+			"	ignore_users = [\"terraform\"]\n" +
+			"	modified_since = var.last_apply\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** `flagged_objects` lists the policies and objects modified since `modified_since` by users other than the `ignore_users`, " +
+			"e.g. changes made in the FMC UI outside of the pipeline's service account. Set `fail_on_flagged` to fail the plan if there are any.",
+		ReadContext: dataSourceFmcDomainAuditRead,
+		Schema: map[string]*schema.Schema{
+			"object_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						v := val.(string)
+						if _, ok := domain_audit_paths[v]; !ok {
+							errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, domainAuditObjectTypes(), v))
+						}
+						return
+					},
+				},
+				Description: "The types of the policies and objects to audit, all of them by default",
+			},
+			"ignore_users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The users whose changes are not flagged, e.g. the service account used by terraform",
+			},
+			"modified_since": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if _, err := time.Parse(time.RFC3339, val.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q must be an RFC 3339 timestamp, got: %q", key, val.(string)))
+					}
+					return
+				},
+				Description: `Only the changes after this RFC 3339 timestamp are flagged, e.g. "2026-10-16T08:00:00Z", all of them by default`,
+			},
+			"fail_on_flagged": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail if any policy or object is flagged",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        domainAuditObjectSchema(),
+				Description: "All the policies and objects of the domain",
+			},
+			"flagged_objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        domainAuditObjectSchema(),
+				Description: "The policies and objects modified since modified_since by users other than the ignore_users",
+			},
+		},
+	}
+}
+
+func domainAuditObjectSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who last modified the policy or object",
+			},
+			"last_modified": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The RFC 3339 timestamp of the last modification",
+			},
+		},
+	}
+}
+
+// domainAuditObjectTypes returns the types which can be audited, sorted
+func domainAuditObjectTypes() []string {
+	objectTypes := []string{}
+	for objectType := range domain_audit_paths {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+	return objectTypes
+}
+
+// flaggedAuditObject checks if the object was modified after since by a user who is not ignored
+func flaggedAuditObject(object *AuditObject, ignoreUsers map[string]bool, since time.Time) bool {
+	if ignoreUsers[strings.ToLower(object.Metadata.Lastuser.Name)] {
+		return false
+	}
+	return object.LastModified().After(since)
+}
+
+func dataSourceFmcDomainAuditRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	objectTypes := domainAuditObjectTypes()
+	if selected := d.Get("object_types").(*schema.Set); selected.Len() > 0 {
+		objectTypes = []string{}
+		for _, objectType := range selected.List() {
+			objectTypes = append(objectTypes, objectType.(string))
+		}
+		sort.Strings(objectTypes)
+	}
+	ignoreUsers := map[string]bool{}
+	for _, user := range d.Get("ignore_users").(*schema.Set).List() {
+		ignoreUsers[strings.ToLower(user.(string))] = true
+	}
+	var since time.Time
+	if entry, ok := d.GetOk("modified_since"); ok {
+		since, _ = time.Parse(time.RFC3339, entry.(string))
+	}
+
+	objects := make([]interface{}, 0)
+	flaggedObjects := make([]interface{}, 0)
+	for _, objectType := range objectTypes {
+		items, err := c.GetFmcAuditObjects(ctx, objectType)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get audit objects",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		for i := range items {
+			object := map[string]interface{}{
+				"id":            items[i].ID,
+				"name":          items[i].Name,
+				"type":          items[i].Type,
+				"last_user":     items[i].Metadata.Lastuser.Name,
+				"last_modified": items[i].LastModified().Format(time.RFC3339),
+			}
+			objects = append(objects, object)
+			if flaggedAuditObject(&items[i], ignoreUsers, since) {
+				flaggedObjects = append(flaggedObjects, object)
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("Audit of %s", strings.Join(objectTypes, ",")))
+
+	for key, value := range map[string]interface{}{
+		"objects":         objects,
+		"flagged_objects": flaggedObjects,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read domain audit",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	if d.Get("fail_on_flagged").(bool) {
+		for _, object := range flaggedObjects {
+			object := object.(map[string]interface{})
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "policy or object modified outside of terraform",
+				Detail:   fmt.Sprintf("%s %s (ID: %s) was modified by %s at %s", object["type"], object["name"], object["id"], object["last_user"], object["last_modified"]),
+			})
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,68 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcExtendedAccessLists() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Extended Access List objects in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_extended_access_lists\" \"pbr_acl\" {\n" +
+			"	name = \"PBR-ACL\"\n" +
+			"}\n" +
+			"```",
+		ReadContext: dataSourceFmcExtendedAccessListsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func dataSourceFmcExtendedAccessListsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	accessList, err := c.GetFmcExtendedAccessListByName(ctx, d.Get("name").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get extended access list",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(accessList.ID)
+
+	if err := d.Set("type", accessList.Type); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read extended access list",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,171 @@
+package fmc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcFtdDeployImpact() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the impact of deploying the pending changes to FTD in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_ftd_deploy_impact\" \"ftd\" {\n" +
+			"	device = data.fmc_devices.ftd.id\n" +
+			"	fail_on_warnings = true\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Use `fail_on_warnings` to fail the plan when deploying would e.g. restart snort, before `fmc_ftd_deploy` deploys the changes.",
+		ReadContext: dataSourceFmcFtdDeployImpactRead,
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the FTD device",
+			},
+			"fail_on_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail if deploying the pending changes has warnings",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"up_to_date": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the device has no pending changes",
+			},
+			"can_be_deployed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether FMC can deploy the pending changes",
+			},
+			"traffic_interruption": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Whether deploying the pending changes interrupts the traffic, e.g. "YES" if snort is restarted`,
+			},
+			"pending_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the policy",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the policy",
+						},
+					},
+				},
+				Description: "The policies with pending changes",
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The warnings for deploying the pending changes",
+			},
+		},
+	}
+}
+
+func dataSourceFmcFtdDeployImpactRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	deviceID := d.Get("device").(string)
+	devices, err := c.GetFmcDeployableDevices(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get deploy impact",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// Devices without pending changes are not deployable
+	device := &DeployableDeviceResponse{Uptodate: true}
+	for i := range devices {
+		if devices[i].Device.ID == deviceID {
+			device = &devices[i]
+		}
+	}
+
+	pendingPolicies := make([]interface{}, 0)
+	warnings := make([]interface{}, 0)
+	if !device.Uptodate {
+		changes, err := c.GetFmcDeployPendingChanges(ctx, deviceID)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get deploy impact",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		for _, change := range changes {
+			pendingPolicies = append(pendingPolicies, map[string]interface{}{
+				"id":   change.Policy.ID,
+				"name": change.Policy.Name,
+				"type": change.Policy.Type,
+			})
+		}
+		if !device.Canbedeployed {
+			warnings = append(warnings, "FMC cannot deploy the pending changes to the device")
+		}
+		if strings.EqualFold(device.Trafficinterruption, "yes") {
+			warnings = append(warnings, "deploying the pending changes restarts snort, which interrupts the traffic")
+		}
+	}
+
+	d.SetId(deviceID)
+
+	for key, value := range map[string]interface{}{
+		"up_to_date":           device.Uptodate,
+		"can_be_deployed":      device.Canbedeployed,
+		"traffic_interruption": device.Trafficinterruption,
+		"pending_policies":     pendingPolicies,
+		"warnings":             warnings,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read deploy impact",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	if d.Get("fail_on_warnings").(bool) {
+		for _, warning := range warnings {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "deploying to the device has warnings",
+				Detail:   warning.(string),
+			})
+		}
+	}
+
+	return diags
+}
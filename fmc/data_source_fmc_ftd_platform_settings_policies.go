@@ -0,0 +1,68 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcFTDPlatformSettingsPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for FTD Platform Settings Policies in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_ftd_platform_settings_policies\" \"platform_settings\" {\n" +
+			"	name = \"FTD Platform Settings\"\n" +
+			"}\n" +
+			"```",
+		ReadContext: dataSourceFmcFTDPlatformSettingsPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func dataSourceFmcFTDPlatformSettingsPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	policy, err := c.GetFmcFTDPlatformSettingsPolicyByName(ctx, c.mappedName("platform_settings", d.Get("name").(string)))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get ftd platform settings policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(policy.ID)
+
+	if err := d.Set("type", policy.Type); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read ftd platform settings policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,90 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcFTDS2SVPNs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for FTD Site to Site VPN topologies in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_ftd_s2s_vpns\" \"branches\" {\n" +
+			"	name = \"Branches\"\n" +
+			"}\n" +
+			"```",
+		ReadContext: dataSourceFmcFTDS2SVPNsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+			"topology_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The topology of this VPN, "POINT_TO_POINT", "HUB_AND_SPOKE" or "FULL_MESH"`,
+			},
+			"ikev1_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether IKEv1 is enabled for this VPN",
+			},
+			"ikev2_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether IKEv2 is enabled for this VPN",
+			},
+		},
+	}
+}
+
+func dataSourceFmcFTDS2SVPNsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	vpn, err := c.GetFmcFTDS2SVPNByName(ctx, d.Get("name").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get ftd s2s vpn",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(vpn.ID)
+
+	for key, value := range map[string]interface{}{
+		"type":          vpn.Type,
+		"topology_type": vpn.Topologytype,
+		"ikev1_enabled": vpn.Ikev1enabled,
+		"ikev2_enabled": vpn.Ikev2enabled,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read ftd s2s vpn",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
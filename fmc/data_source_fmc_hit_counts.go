@@ -0,0 +1,143 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcHitCounts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the Hit Counts of the rules of an Access or Prefilter Policy on a device in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_hit_counts\" \"ftd\" {\n" +
+			"	policy_id = data.fmc_access_policies.acp.id\n" +
+			"	device_id = data.fmc_devices.ftd.id\n" +
+			"}\n" +
+			"\n" +
+			"output \"unused_rules\" {\n" +
+			"	value = [for rule in data.fmc_hit_counts.ftd.rules : rule.name if rule.hit_count == 0]\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The hit counts are operational data, they change on every read and are reset when the rules are reset on the device.",
+		ReadContext: dataSourceFmcHitCountsRead,
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the policy",
+			},
+			"policy_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "AccessPolicy",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if _, ok := hit_count_policy_paths[v]; !ok {
+						errs = append(errs, fmt.Errorf(`%q must be "AccessPolicy" or "PrefilterPolicy", got: %q`, key, v))
+					}
+					return
+				},
+				Description: `The type of the policy, "AccessPolicy" or "PrefilterPolicy", defaults to "AccessPolicy"`,
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the device",
+			},
+			"hit_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The hit counts keyed by rule ID",
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule",
+						},
+						"hit_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hits of the rule",
+						},
+						"first_hit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the first hit of the rule",
+						},
+						"last_hit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the last hit of the rule",
+						},
+					},
+				},
+				Description: "The hit counts of the rules, in the order returned by FMC",
+			},
+		},
+	}
+}
+
+func dataSourceFmcHitCountsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	policyId, deviceId := d.Get("policy_id").(string), d.Get("device_id").(string)
+	items, err := c.GetFmcHitCounts(ctx, d.Get("policy_type").(string), policyId, deviceId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get hit counts",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	hitCounts := make(map[string]interface{}, len(items))
+	rules := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		hitCounts[item.Rule.ID] = item.Hitcount
+		rules = append(rules, map[string]interface{}{
+			"id":        item.Rule.ID,
+			"name":      item.Rule.Name,
+			"hit_count": item.Hitcount,
+			"first_hit": item.Firsthittimestamp,
+			"last_hit":  item.Lasthittimestamp,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", policyId, deviceId))
+
+	for key, value := range map[string]interface{}{
+		"hit_counts": hitCounts,
+		"rules":      rules,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read hit counts",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,86 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcHostScanPackages() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for HostScan packages in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_hostscan_packages\" \"hostscan\" {\n" +
+			"	name = \"hostscan_4.10.07061\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The FMC API does not support uploading HostScan packages or managing dynamic access policies, " +
+			"they have to be managed in the FMC UI.",
+		ReadContext: dataSourceFmcHostScanPackagesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this resource",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the HostScan package",
+			},
+		},
+	}
+}
+
+func dataSourceFmcHostScanPackagesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	hostScanPackage, err := c.GetFmcHostScanPackageByName(ctx, d.Get("name").(string))
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get hostscan package",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(hostScanPackage.ID)
+
+	for key, value := range map[string]interface{}{
+		"type":        hostScanPackage.Type,
+		"description": hostScanPackage.Description,
+		"version":     hostScanPackage.Version,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read hostscan package",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
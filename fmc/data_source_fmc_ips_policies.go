@@ -42,7 +42,7 @@ func dataSourceFmcIPSPoliciesRead(ctx context.Context, d *schema.ResourceData, m
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
-	ipsPolicy, err := c.GetFmcIPSPolicyByName(ctx, d.Get("name").(string))
+	ipsPolicy, err := c.GetFmcIPSPolicyByName(ctx, c.mappedName("ips_policy", d.Get("name").(string)))
 
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
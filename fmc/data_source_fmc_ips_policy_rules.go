@@ -0,0 +1,107 @@
+package fmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcIPSPolicyRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the rule states of an IPS Policy in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_ips_policy_rules\" \"ips_policy_rules\" {\n" +
+			"	ips_policy = data.fmc_ips_policies.ips_policy.id\n" +
+			"}\n" +
+			"\n" +
+			"resource \"local_file\" \"ips_policy_rules\" {\n" +
+			"	content = data.fmc_ips_policy_rules.ips_policy_rules.rules_json\n" +
+			"	filename = \"ips_policy_rules.json\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The rules are keyed by `gid:sid`, so the exports of two environments can be compared with a plain diff.",
+		ReadContext: dataSourceFmcIPSPolicyRulesRead,
+		Schema: map[string]*schema.Schema{
+			"ips_policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the IPS policy",
+			},
+			"only_overrides": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only export the rules whose state is overridden in the IPS policy",
+			},
+			"rules_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON object of the effective state of each rule, keyed by gid:sid",
+			},
+			"rule_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of rules in rules_json",
+			},
+		},
+	}
+}
+
+func dataSourceFmcIPSPolicyRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	ipsPolicyId := d.Get("ips_policy").(string)
+	rules, err := c.GetFmcIPSPolicyRules(ctx, ipsPolicyId)
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get ips policy rules",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	onlyOverrides := d.Get("only_overrides").(bool)
+	states := make(map[string]string, len(rules))
+	for i := range rules {
+		if onlyOverrides && !rules[i].Overridden(ipsPolicyId) {
+			continue
+		}
+		states[fmt.Sprintf("%d:%d", rules[i].Gid, rules[i].Sid)] = rules[i].State(ipsPolicyId)
+	}
+	// Indented and with sorted keys so that diffs between exports are line based
+	rulesJSON, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read ips policy rules",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(ipsPolicyId)
+
+	for key, value := range map[string]interface{}{
+		"rules_json": string(rulesJSON),
+		"rule_count": len(states),
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read ips policy rules",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,175 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcNetworkGroupObjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Network Group Objects in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_network_group_objects\" \"PrivateGroup\" {\n" +
+			"	name = \"PrivateGroup\"\n" +
+			"}\n" +
+			"```\n" +
+			"Either the id or the name can be specified, the id is used if both are specified. " +
+			"The values attribute contains the values of all the objects and literals in the group, including those of nested groups.",
+		ReadContext: dataSourceFmcNetworkGroupObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of this resource",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of this resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of this resource",
+						},
+					},
+				},
+				Description: "List of network objects in this resource",
+			},
+			"literals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of this resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of this resource",
+						},
+					},
+				},
+				Description: "List of network literals in this resource",
+			},
+			"values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Flattened values of all the objects and literals in this resource, including nested groups",
+			},
+		},
+	}
+}
+
+func dataSourceFmcNetworkGroupObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	idInput, okId := d.GetOk("id")
+	nameInput, okName := d.GetOk("name")
+	var (
+		item *NetworkGroupObjectResponse
+		err  error
+	)
+	switch {
+	case okId:
+		item, err = c.GetFmcNetworkGroupObject(ctx, idInput.(string))
+	case okName:
+		item, err = c.GetFmcNetworkGroupObjectByName(ctx, c.mappedName("network_group", nameInput.(string)))
+	default:
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "No id or name provided, please provide any one",
+			Detail:   "Please set one of the values to filter the datasource by",
+		})
+		return diags
+	}
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get network group object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	values, err := c.GetFmcNetworkGroupObjectValues(ctx, item.ID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get network group object values",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(item.ID)
+
+	objects := make([]interface{}, 0, len(item.Objects))
+	for _, obj := range item.Objects {
+		objects = append(objects, map[string]interface{}{
+			"id":   obj.ID,
+			"type": obj.Type,
+		})
+	}
+	literals := make([]interface{}, 0, len(item.Literals))
+	for _, lit := range item.Literals {
+		literals = append(literals, map[string]interface{}{
+			"value": lit.Value,
+			"type":  lit.Type,
+		})
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":        item.Name,
+		"description": item.Description,
+		"type":        item.Type,
+		"objects":     objects,
+		"literals":    literals,
+		"values":      values,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read network group object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -16,7 +16,8 @@ func dataSourceFmcNetworkObjects() *schema.Resource {
 			"	name = \"VLAN825-Private\"\n" +
 			"}\n" +
 			"```\n" +
-			"Any one of the id, name or value can be specified. The first filter in the order of id, name and value will be used, and the rest will be ignored if multiple are specified.",
+			"Any one of the id, name or value can be specified. The first filter in the order of id, name and value will be used, and the rest will be ignored if multiple are specified. " +
+			"Additional FMC API filters can be given in `filters`, e.g. `filters = { unusedOnly = \"true\" }` to only find objects which are not in use.",
 		ReadContext: dataSourceFmcNetworkObjectsRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -37,6 +38,19 @@ func dataSourceFmcNetworkObjects() *schema.Resource {
 				Computed:    true,
 				Description: "The value of this resource",
 			},
+			"filters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Additional filters of the FMC API for the lookup by name or value",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of this resource",
+			},
 			"type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -69,9 +83,9 @@ func dataSourceFmcNetworkObjectsRead(ctx context.Context, d *schema.ResourceData
 	case okId:
 		item, err = c.GetFmcNetworkObject(ctx, idInput.(string))
 	case okName:
-		item, err = c.GetFmcNetworkObjectByNameOrValue(ctx, nameInput.(string))
+		item, err = c.GetFmcNetworkObjectByNameOrValue(ctx, c.mappedName("network", nameInput.(string)), expandQueryFilters(d.Get("filters")))
 	case okValue:
-		item, err = c.GetFmcNetworkObjectByNameOrValue(ctx, valueInput.(string))
+		item, err = c.GetFmcNetworkObjectByNameOrValue(ctx, valueInput.(string), expandQueryFilters(d.Get("filters")))
 	default:
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -110,6 +124,15 @@ func dataSourceFmcNetworkObjectsRead(ctx context.Context, d *schema.ResourceData
 		return diags
 	}
 
+	if err := d.Set("description", item.Description); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read network object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	if err := d.Set("type", item.Type); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -16,7 +16,8 @@ func dataSourceFmcPortObjects() *schema.Resource {
 			"	name = \"DNS_over_TCP\"\n" +
 			"}\n" +
 			"```\n" +
-			"Any one of the id, name or port can be specified. The first filter in the order of id, name and port will be used, and the rest will be ignored if multiple are specified.",
+			"Any one of the id, name or port can be specified. The first filter in the order of id, name and port will be used, and the rest will be ignored if multiple are specified. " +
+			"Additional FMC API filters can be given in `filters`, e.g. `filters = { unusedOnly = \"true\" }` to only find objects which are not in use.",
 		ReadContext: dataSourceFmcPortObjectsRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -43,6 +44,14 @@ func dataSourceFmcPortObjects() *schema.Resource {
 				Computed:    true,
 				Description: "The protocol of this resource",
 			},
+			"filters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Additional filters of the FMC API for the lookup by name or port",
+			},
 			"type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -76,9 +85,9 @@ func dataSourceFmcPortObjectsRead(ctx context.Context, d *schema.ResourceData, m
 	case okId:
 		item, err = c.GetFmcPortObject(ctx, idInput.(string))
 	case okName:
-		item, err = c.GetFmcPortObjectByNameOrPort(ctx, nameInput.(string))
+		item, err = c.GetFmcPortObjectByNameOrPort(ctx, c.mappedName("port", nameInput.(string)), expandQueryFilters(d.Get("filters")))
 	case okPort:
-		item, err = c.GetFmcPortObjectByNameOrPort(ctx, portInput.(string))
+		item, err = c.GetFmcPortObjectByNameOrPort(ctx, portInput.(string), expandQueryFilters(d.Get("filters")))
 	default:
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,191 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The show command with the statistics of the flows offloaded to the hardware, on the devices which support it
+var flow_offload_statistics_command string = "show flow-offload statistics"
+
+func dataSourceFmcPrefilterFastpathStatistics() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the statistics of the Fastpath rules of a Prefilter Policy on a device in FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_prefilter_fastpath_statistics\" \"ftd\" {\n" +
+			"	policy_id = fmc_prefilter_policy.prefilter_policy.id\n" +
+			"	device_id = data.fmc_devices.ftd.id\n" +
+			"	depends_on = [fmc_ftd_deploy.ftd]\n" +
+			"}\n" +
+			"\n" +
+			"output \"ineffective_fastpath_rules\" {\n" +
+			"	value = data.fmc_prefilter_fastpath_statistics.ftd.unused_rules\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The hit counts are operational data, read after the deployment they show whether the fastpath rules match the traffic " +
+			"they were written for. Set `flow_offload` to also get the output of `show flow-offload statistics`, which needs FMC 7.1+ " +
+			"and a device with flow offload enabled.",
+		ReadContext: dataSourceFmcPrefilterFastpathStatisticsRead,
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the prefilter policy",
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the device",
+			},
+			"flow_offload": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also read the flow offload statistics of the device",
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the rule is enabled",
+						},
+						"hit_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hits of the rule",
+						},
+						"last_hit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the last hit of the rule",
+						},
+					},
+				},
+				Description: "The fastpath rules of the policy in order, with their hit counts on the device",
+			},
+			"total_hits": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of hits of all the fastpath rules",
+			},
+			"unused_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The names of the enabled fastpath rules without hits",
+			},
+			"flow_offload_output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The output of show flow-offload statistics, if flow_offload is set",
+			},
+		},
+	}
+}
+
+func dataSourceFmcPrefilterFastpathStatisticsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	policyId, deviceId := d.Get("policy_id").(string), d.Get("device_id").(string)
+	prefilterRules, err := c.GetFmcPrefilterRules(ctx, policyId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get prefilter rules",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	hitCounts, err := c.GetFmcHitCounts(ctx, "PrefilterPolicy", policyId, deviceId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get hit counts",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	hitCountsByRule := make(map[string]HitCount, len(hitCounts))
+	for _, hitCount := range hitCounts {
+		hitCountsByRule[hitCount.Rule.ID] = hitCount
+	}
+
+	rules := []interface{}{}
+	unusedRules := []interface{}{}
+	totalHits := 0
+	for _, rule := range prefilterRules {
+		if rule.Action != "FASTPATH" {
+			continue
+		}
+		hitCount := hitCountsByRule[rule.ID]
+		rules = append(rules, map[string]interface{}{
+			"id":        rule.ID,
+			"name":      rule.Name,
+			"enabled":   rule.Enabled,
+			"hit_count": hitCount.Hitcount,
+			"last_hit":  hitCount.Lasthittimestamp,
+		})
+		totalHits += hitCount.Hitcount
+		if rule.Enabled && hitCount.Hitcount == 0 {
+			unusedRules = append(unusedRules, rule.Name)
+		}
+	}
+
+	flowOffloadOutput := ""
+	if d.Get("flow_offload").(bool) {
+		err = c.requireFmcVersion(ctx, "flow_offload of fmc_prefilter_fastpath_statistics", device_command_minimum_version)
+		if err == nil {
+			flowOffloadOutput, err = c.GetFmcDeviceCommandOutput(ctx, deviceId, flow_offload_statistics_command)
+		}
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get flow offload statistics",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", policyId, deviceId))
+
+	for key, value := range map[string]interface{}{
+		"rules":               rules,
+		"total_hits":          totalHits,
+		"unused_rules":        unusedRules,
+		"flow_offload_output": flowOffloadOutput,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read fastpath statistics",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -42,7 +42,7 @@ func dataSourceFmcSyslogAlertsRead(ctx context.Context, d *schema.ResourceData,
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
-	syslogAlert, err := c.GetFmcSyslogAlertByName(ctx, d.Get("name").(string))
+	syslogAlert, err := c.GetFmcSyslogAlertByName(ctx, c.mappedName("syslog_alert", d.Get("name").(string)))
 
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
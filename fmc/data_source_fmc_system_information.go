@@ -0,0 +1,107 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFmcSystemInformation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the version information of FMC\n\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"data \"fmc_system_information\" \"fmc\" {\n" +
+			"}\n" +
+			"\n" +
+			"output \"fmc_version\" {\n" +
+			"	value = data.fmc_system_information.fmc.version\n" +
+			"}\n" +
+			"```",
+		ReadContext: dataSourceFmcSystemInformationRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of this resource",
+			},
+			"server_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The version of FMC as returned by FMC, e.g. "7.2.0 (build 82)"`,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The version of FMC, e.g. "7.2.0"`,
+			},
+			"build": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The build of FMC, e.g. "82"`,
+			},
+			"vdb_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the vulnerability database (VDB)",
+			},
+			"sru_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the intrusion rules (SRU)",
+			},
+			"lsp_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the lightweight security package (LSP) of the intrusion rules",
+			},
+			"geo_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the geolocation database",
+			},
+		},
+	}
+}
+
+func dataSourceFmcSystemInformationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	serverVersion, err := c.GetFmcServerVersion(ctx)
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get system information",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(c.host)
+
+	version, build := serverVersion.Version()
+	for key, value := range map[string]interface{}{
+		"server_version": serverVersion.Serverversion,
+		"version":        version,
+		"build":          build,
+		"vdb_version":    serverVersion.Vdbversion,
+		"sru_version":    serverVersion.Sruversion,
+		"lsp_version":    serverVersion.Lspversion,
+		"geo_version":    serverVersion.Geoversion,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read system information",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -16,7 +16,8 @@ func dataSourceFmcURLObjects() *schema.Resource {
 			"	name = \"DNAC\"\n" +
 			"}\n" +
 			"```\n" +
-			"Any one of the id, name or value can be specified. The first filter in the order of id, name and value will be used, and the rest will be ignored if multiple are specified.",
+			"Any one of the id, name or value can be specified. The first filter in the order of id, name and value will be used, and the rest will be ignored if multiple are specified. " +
+			"Additional FMC API filters can be given in `filters`, e.g. `filters = { unusedOnly = \"true\" }` to only find objects which are not in use.",
 		ReadContext: dataSourceFmcURLObjectsRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -37,6 +38,14 @@ func dataSourceFmcURLObjects() *schema.Resource {
 				Computed:    true,
 				Description: "The URL of this resource",
 			},
+			"filters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Additional filters of the FMC API for the lookup by name or value",
+			},
 			"type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -69,9 +78,9 @@ func dataSourceFmcURLObjectsRead(ctx context.Context, d *schema.ResourceData, m
 	case okId:
 		item, err = c.GetFmcURLObject(ctx, idInput.(string))
 	case okName:
-		item, err = c.GetFmcURLObjectByNameOrValue(ctx, nameInput.(string))
+		item, err = c.GetFmcURLObjectByNameOrValue(ctx, c.mappedName("url", nameInput.(string)), expandQueryFilters(d.Get("filters")))
 	case okValue:
-		item, err = c.GetFmcURLObjectByNameOrValue(ctx, valueInput.(string))
+		item, err = c.GetFmcURLObjectByNameOrValue(ctx, valueInput.(string), expandQueryFilters(d.Get("filters")))
 	default:
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
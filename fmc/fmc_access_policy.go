@@ -22,6 +22,8 @@ type AccessPolicyDefaultAction struct {
 	Sendeventstofmc bool                   `json:"sendEventsToFMC"`
 	Action          string                 `json:"action"`
 	ID              string                 `json:"id,omitempty"`
+	Enablesyslog    bool                   `json:"enableSyslog"`
+	Syslogseverity  string                 `json:"syslogSeverity,omitempty"`
 	// Variableset struct {
 	// 	ID   string `json:"id"`
 	// 	Type string `json:"type"`
@@ -33,11 +35,12 @@ type AccessPolicyDefaultAction struct {
 }
 
 type AccessPolicy struct {
-	ID            string                    `json:"id,omitempty"`
-	Type          string                    `json:"type"`
-	Name          string                    `json:"name"`
-	Description   string                    `json:"description"`
-	Defaultaction AccessPolicyDefaultAction `json:"defaultAction"`
+	ID                     string                    `json:"id,omitempty"`
+	Type                   string                    `json:"type"`
+	Name                   string                    `json:"name"`
+	Description            string                    `json:"description"`
+	Defaultaction          AccessPolicyDefaultAction `json:"defaultAction"`
+	Prefilterpolicysetting *AccessPolicySubConfig    `json:"prefilterPolicySetting,omitempty"`
 }
 
 type AccessPolicyResponse struct {
@@ -49,10 +52,11 @@ type AccessPolicyResponse struct {
 			Self string `json:"self"`
 		} `json:"links"`
 	} `json:"rules"`
-	Name          string                    `json:"name"`
-	Description   string                    `json:"description"`
-	ID            string                    `json:"id"`
-	Defaultaction AccessPolicyDefaultAction `json:"defaultAction"`
+	Name                   string                    `json:"name"`
+	Description            string                    `json:"description"`
+	ID                     string                    `json:"id"`
+	Defaultaction          AccessPolicyDefaultAction `json:"defaultAction"`
+	Prefilterpolicysetting *AccessPolicySubConfig    `json:"prefilterPolicySetting"`
 }
 
 type AccessPoliciesResponse struct {
@@ -61,10 +65,39 @@ type AccessPoliciesResponse struct {
 		ID   string `json:"id"`
 		Name string `json:"name"`
 	} `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcAccessPolicies returns all the access policies of the domain, without their details
+func (v *Client) GetFmcAccessPolicies(ctx context.Context) (*AccessPoliciesResponse, error) {
+	policies := &AccessPoliciesResponse{}
+	for offset := 0; ; {
+		url := v.buildURL("policy/accesspolicies", NewQuery().Expanded(false).Offset(offset).Limit(fmc_query_limit))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting access policies: %s - %s", url, err.Error())
+		}
+		res := &AccessPoliciesResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting access policies: %s - %s", url, err.Error())
+		}
+		policies.Items = append(policies.Items, res.Items...)
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			policies.Paging.Count = offset
+			return policies, nil
+		}
+	}
 }
 
 func (v *Client) GetFmcAccessPolicyByName(ctx context.Context, name string) (*AccessPolicyResponse, error) {
-	url := fmt.Sprintf("%s/policy/accesspolicies?expanded=false&filter=name:%s", v.domainBaseURL, name)
+	url := v.buildURL("policy/accesspolicies", NewQuery().Expanded(false).Filter("name", name))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting access policy by name/value: %s - %s", url, err.Error())
@@ -151,3 +184,128 @@ func (v *Client) DeleteFmcAccessPolicy(ctx context.Context, id string) error {
 	err = v.DoRequest(req, nil, http.StatusOK)
 	return err
 }
+
+type AccessPolicyInheritanceSetting struct {
+	ID               string                 `json:"id"`
+	Type             string                 `json:"type"`
+	Baseaccesspolicy *AccessPolicySubConfig `json:"baseAccessPolicy,omitempty"`
+}
+
+func (v *Client) GetFmcAccessPolicyInheritanceSetting(ctx context.Context, acp_id string) (*AccessPolicyInheritanceSetting, error) {
+	url := fmt.Sprintf("%s/policy/accesspolicies/%s/inheritancesettings/%s", v.domainBaseURL, acp_id, acp_id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting access policy inheritance settings: %s - %s", url, err.Error())
+	}
+	item := &AccessPolicyInheritanceSetting{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting access policy inheritance settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcAccessPolicyInheritanceSetting(ctx context.Context, acp_id string, setting *AccessPolicyInheritanceSetting) (*AccessPolicyInheritanceSetting, error) {
+	url := fmt.Sprintf("%s/policy/accesspolicies/%s/inheritancesettings/%s", v.domainBaseURL, acp_id, acp_id)
+	body, err := json.Marshal(&setting)
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy inheritance settings: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy inheritance settings: %s - %s", url, err.Error())
+	}
+	item := &AccessPolicyInheritanceSetting{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy inheritance settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+type AccessPolicyAdvancedSetting struct {
+	ID                               string                 `json:"id"`
+	Type                             string                 `json:"type"`
+	Enabletlsserveridentitydiscovery bool                   `json:"enableTLSServerIdentityDiscovery"`
+	Interactiveblockallowtime        int                    `json:"interactiveBlockAllowTime"`
+	Inspecttrafficduringapply        bool                   `json:"inspectTrafficDuringApply"`
+	Identitypolicysetting            *AccessPolicySubConfig `json:"identityPolicySetting,omitempty"`
+}
+
+func (v *Client) GetFmcAccessPolicyAdvancedSetting(ctx context.Context, acp_id string) (*AccessPolicyAdvancedSetting, error) {
+	url := fmt.Sprintf("%s/policy/accesspolicies/%s/advancedsettings/%s", v.domainBaseURL, acp_id, acp_id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting access policy advanced settings: %s - %s", url, err.Error())
+	}
+	item := &AccessPolicyAdvancedSetting{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting access policy advanced settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcAccessPolicyAdvancedSetting(ctx context.Context, acp_id string, setting *AccessPolicyAdvancedSetting) (*AccessPolicyAdvancedSetting, error) {
+	url := fmt.Sprintf("%s/policy/accesspolicies/%s/advancedsettings/%s", v.domainBaseURL, acp_id, acp_id)
+	body, err := json.Marshal(&setting)
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy advanced settings: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy advanced settings: %s - %s", url, err.Error())
+	}
+	item := &AccessPolicyAdvancedSetting{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy advanced settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+type AccessPolicySecurityIntelligenceObjects struct {
+	Blocklist      []AccessPolicySubConfig `json:"blockList"`
+	Donotblocklist []AccessPolicySubConfig `json:"doNotBlockList"`
+	Logging        bool                    `json:"logging"`
+}
+
+type AccessPolicySecurityIntelligence struct {
+	ID        string                                  `json:"id"`
+	Type      string                                  `json:"type"`
+	Networks  AccessPolicySecurityIntelligenceObjects `json:"networks"`
+	Urls      AccessPolicySecurityIntelligenceObjects `json:"urls"`
+	Dnspolicy *AccessPolicySubConfig                  `json:"dnsPolicy,omitempty"`
+}
+
+func (v *Client) GetFmcAccessPolicySecurityIntelligence(ctx context.Context, acp_id string) (*AccessPolicySecurityIntelligence, error) {
+	url := fmt.Sprintf("%s/policy/accesspolicies/%s/securityintelligencepolicies/%s", v.domainBaseURL, acp_id, acp_id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting access policy security intelligence: %s - %s", url, err.Error())
+	}
+	item := &AccessPolicySecurityIntelligence{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting access policy security intelligence: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcAccessPolicySecurityIntelligence(ctx context.Context, acp_id string, setting *AccessPolicySecurityIntelligence) (*AccessPolicySecurityIntelligence, error) {
+	url := fmt.Sprintf("%s/policy/accesspolicies/%s/securityintelligencepolicies/%s", v.domainBaseURL, acp_id, acp_id)
+	body, err := json.Marshal(&setting)
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy security intelligence: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy security intelligence: %s - %s", url, err.Error())
+	}
+	item := &AccessPolicySecurityIntelligence{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating access policy security intelligence: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
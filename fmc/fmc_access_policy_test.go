@@ -0,0 +1,37 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetFmcAccessPolicies(t *testing.T) {
+	names := []string{"site-1-acp", "site-2-acp", "lab-acp"}
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Two policies per page, to check the paging
+		offset := 0
+		_, _ = fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		items := []string{}
+		for i := offset; i < len(names) && i < offset+2; i++ {
+			items = append(items, fmt.Sprintf(`{"id": "%d", "name": "%s", "type": "AccessPolicy"}`, i, names[i]))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"items": [%s], "paging": {"count": %d}}`, strings.Join(items, ","), len(names))
+	})
+
+	policies, err := c.GetFmcAccessPolicies(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policies.Items) != len(names) {
+		t.Fatalf("expected %d policies, got %v", len(names), policies.Items)
+	}
+	for i, item := range policies.Items {
+		if item.Name != names[i] {
+			t.Errorf("expected policy %s, got %s", names[i], item.Name)
+		}
+	}
+}
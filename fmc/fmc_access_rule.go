@@ -5,7 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
+	"sync"
 )
 
 type AccessRuleSubConfig struct {
@@ -17,6 +20,60 @@ type AccessRuleSubConfigs struct {
 	Objects []AccessRuleSubConfig `json:"objects"`
 }
 
+type AccessRuleNetworkLiteral struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type AccessRuleNetworks struct {
+	Objects  []AccessRuleSubConfig      `json:"objects"`
+	Literals []AccessRuleNetworkLiteral `json:"literals,omitempty"`
+}
+
+type AccessRulePortLiteral struct {
+	Type     string `json:"type"`
+	Protocol string `json:"protocol"`
+	Port     string `json:"port,omitempty"`
+}
+
+type AccessRulePorts struct {
+	Objects  []AccessRuleSubConfig   `json:"objects"`
+	Literals []AccessRulePortLiteral `json:"literals,omitempty"`
+}
+
+type AccessRuleURLLiteral struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type AccessRuleURLCategory struct {
+	Type       string              `json:"type"`
+	Category   AccessRuleSubConfig `json:"category"`
+	Reputation string              `json:"reputation,omitempty"`
+}
+
+type AccessRuleURLs struct {
+	Objects                     []AccessRuleSubConfig   `json:"objects"`
+	Literals                    []AccessRuleURLLiteral  `json:"literals,omitempty"`
+	Urlcategorieswithreputation []AccessRuleURLCategory `json:"urlCategoriesWithReputation,omitempty"`
+}
+
+type AccessRuleVlanTagLiteral struct {
+	Type     string `json:"type"`
+	Starttag int    `json:"startTag"`
+	Endtag   int    `json:"endTag"`
+}
+
+type AccessRuleVlanTags struct {
+	Objects  []AccessRuleSubConfig      `json:"objects,omitempty"`
+	Literals []AccessRuleVlanTagLiteral `json:"literals,omitempty"`
+}
+
+// Applications are the only condition whose objects are not sent as "objects"
+type AccessRuleApplications struct {
+	Applications []AccessRuleSubConfig `json:"applications"`
+}
+
 type AccessRuleDefaultAction struct {
 	Intrusionpolicy AccessRuleSubConfig `json:"intrusionPolicy"`
 	Syslogconfig    AccessRuleSubConfig `json:"syslogConfig"`
@@ -35,29 +92,47 @@ type AccessRuleDefaultAction struct {
 	// } `json:"snmpConfig"`
 }
 
+type AccessRuleSafeSearch struct {
+	Enabled                  bool   `json:"enabled"`
+	Unsupportedsearchtraffic string `json:"unsupportedSearchTraffic,omitempty"`
+}
+
+type AccessRuleYoutubeEdu struct {
+	Enabled                  bool   `json:"enabled"`
+	Customid                 string `json:"customId,omitempty"`
+	Unsupportedsearchtraffic string `json:"unsupportedSearchTraffic,omitempty"`
+}
+
 type AccessRule struct {
-	ID                  string               `json:"id,omitempty"`
-	Name                string               `json:"name"`
-	Type                string               `json:"type"`
-	Action              string               `json:"action"`
-	Syslogseverity      string               `json:"syslogSeverity,omitempty"`
-	Enablesyslog        bool                 `json:"enableSyslog"`
-	Enabled             bool                 `json:"enabled"`
-	Sendeventstofmc     bool                 `json:"sendEventsToFMC"`
-	Logfiles            bool                 `json:"logFiles"`
-	Logbegin            bool                 `json:"logBegin"`
-	Logend              bool                 `json:"logEnd"`
-	Sourcezones         AccessRuleSubConfigs `json:"sourceZones,omitempty"`
-	Destinationzones    AccessRuleSubConfigs `json:"destinationZones,omitempty"`
-	Sourcenetworks      AccessRuleSubConfigs `json:"sourceNetworks,omitempty"`
-	Destinationnetworks AccessRuleSubConfigs `json:"destinationNetworks,omitempty"`
-	Sourceports         AccessRuleSubConfigs `json:"sourcePorts,omitempty"`
-	Destinationports    AccessRuleSubConfigs `json:"destinationPorts,omitempty"`
-	Urls                AccessRuleSubConfigs `json:"urls,omitempty"`
-	Ipspolicy           *AccessRuleSubConfig `json:"ipsPolicy,omitempty"`
-	Filepolicy          *AccessRuleSubConfig `json:"filePolicy,omitempty"`
-	Syslogconfig        *AccessRuleSubConfig `json:"syslogConfig,omitempty"`
-	Newcomments         []string             `json:"newComments,omitempty"`
+	ID                      string                  `json:"id,omitempty"`
+	Name                    string                  `json:"name"`
+	Type                    string                  `json:"type"`
+	Action                  string                  `json:"action"`
+	Syslogseverity          string                  `json:"syslogSeverity,omitempty"`
+	Enablesyslog            bool                    `json:"enableSyslog"`
+	Enabled                 bool                    `json:"enabled"`
+	Sendeventstofmc         bool                    `json:"sendEventsToFMC"`
+	Logfiles                bool                    `json:"logFiles"`
+	Logbegin                bool                    `json:"logBegin"`
+	Logend                  bool                    `json:"logEnd"`
+	Sourcezones             AccessRuleSubConfigs    `json:"sourceZones,omitempty"`
+	Destinationzones        AccessRuleSubConfigs    `json:"destinationZones,omitempty"`
+	Sourcenetworks          AccessRuleNetworks      `json:"sourceNetworks,omitempty"`
+	Destinationnetworks     AccessRuleNetworks      `json:"destinationNetworks,omitempty"`
+	Sourceports             AccessRulePorts         `json:"sourcePorts,omitempty"`
+	Destinationports        AccessRulePorts         `json:"destinationPorts,omitempty"`
+	Urls                    AccessRuleURLs          `json:"urls,omitempty"`
+	Sourcesecuritygrouptags *AccessRuleSubConfigs   `json:"sourceSecurityGroupTags,omitempty"`
+	Applications            *AccessRuleApplications `json:"applications,omitempty"`
+	Users                   *AccessRuleSubConfigs   `json:"users,omitempty"`
+	Vlantags                *AccessRuleVlanTags     `json:"vlanTags,omitempty"`
+	Ipspolicy               *AccessRuleSubConfig    `json:"ipsPolicy,omitempty"`
+	Filepolicy              *AccessRuleSubConfig    `json:"filePolicy,omitempty"`
+	Syslogconfig            *AccessRuleSubConfig    `json:"syslogConfig,omitempty"`
+	Timerangeobjects        []AccessRuleSubConfig   `json:"timeRangeObjects,omitempty"`
+	Newcomments             []string                `json:"newComments,omitempty"`
+	Safesearch              *AccessRuleSafeSearch   `json:"safeSearch,omitempty"`
+	Youtubeedu              *AccessRuleYoutubeEdu   `json:"youtubeEDU,omitempty"`
 }
 
 type AccessRuleUpdate AccessRule
@@ -70,13 +145,23 @@ type AccessRuleResponseObject struct {
 	Version     string `json:"version"`
 }
 
+type AccessRuleMetadata struct {
+	Ruleindex int    `json:"ruleIndex"`
+	Section   string `json:"section"`
+	Category  string `json:"category"`
+}
+
+// UnmarshalJSON ignores the unknown fields even with strict decoding, only the position of the rule is read from
+// its metadata
+func (m *AccessRuleMetadata) UnmarshalJSON(data []byte) error {
+	type accessRuleMetadata AccessRuleMetadata
+	return json.Unmarshal(data, (*accessRuleMetadata)(m))
+}
+
 type AccessRuleResponse struct {
 	Sourcenetworks struct {
 		Objects  []AccessRuleResponseObject `json:"objects"`
-		Literals []struct {
-			Type  string `json:"type"`
-			Value string `json:"value"`
-		} `json:"literals"`
+		Literals []AccessRuleNetworkLiteral `json:"literals"`
 	} `json:"sourceNetworks"`
 	Syslogseverity string `json:"syslogSeverity"`
 	Sourcezones    struct {
@@ -108,9 +193,24 @@ type AccessRuleResponse struct {
 			URL  string `json:"url"`
 		} `json:"literals"`
 	} `json:"urls"`
-	Syslogconfig        AccessRuleResponseObject `json:"syslogConfig"`
-	Destinationnetworks struct {
+	Sourcesecuritygrouptags struct {
+		Objects []AccessRuleResponseObject `json:"objects"`
+	} `json:"sourceSecurityGroupTags"`
+	Applications struct {
+		Applications []AccessRuleResponseObject `json:"applications"`
+	} `json:"applications"`
+	Users struct {
 		Objects []AccessRuleResponseObject `json:"objects"`
+	} `json:"users"`
+	Vlantags struct {
+		Objects  []AccessRuleResponseObject `json:"objects"`
+		Literals []AccessRuleVlanTagLiteral `json:"literals"`
+	} `json:"vlanTags"`
+	Syslogconfig        AccessRuleResponseObject   `json:"syslogConfig"`
+	Timerangeobjects    []AccessRuleResponseObject `json:"timeRangeObjects"`
+	Destinationnetworks struct {
+		Objects  []AccessRuleResponseObject `json:"objects"`
+		Literals []AccessRuleNetworkLiteral `json:"literals"`
 	} `json:"destinationNetworks"`
 	Action           string `json:"action"`
 	ID               string `json:"id"`
@@ -118,48 +218,224 @@ type AccessRuleResponse struct {
 	Logbegin         bool   `json:"logBegin"`
 	Sendeventstofmc  bool   `json:"sendEventsToFMC"`
 	Destinationports struct {
-		Objects []AccessRuleResponseObject `json:"objects"`
+		Objects  []AccessRuleResponseObject `json:"objects"`
+		Literals []AccessRulePortLiteral    `json:"literals"`
 	} `json:"destinationPorts"`
 	Sourceports struct {
-		Objects []AccessRuleResponseObject `json:"objects"`
+		Objects  []AccessRuleResponseObject `json:"objects"`
+		Literals []AccessRulePortLiteral    `json:"literals"`
 	} `json:"sourcePorts"`
-	Version     string                   `json:"version"`
-	Variableset AccessRuleResponseObject `json:"variableSet"`
-	Logfiles    bool                     `json:"logFiles"`
-	Filepolicy  AccessRuleResponseObject `json:"filePolicy"`
-	Ipspolicy   AccessRuleResponseObject `json:"ipsPolicy"`
-	Name        string                   `json:"name"`
+	Version            string                   `json:"version"`
+	Variableset        AccessRuleResponseObject `json:"variableSet"`
+	Logfiles           bool                     `json:"logFiles"`
+	Filepolicy         AccessRuleResponseObject `json:"filePolicy"`
+	Ipspolicy          AccessRuleResponseObject `json:"ipsPolicy"`
+	Name               string                   `json:"name"`
+	Safesearch         *AccessRuleSafeSearch    `json:"safeSearch"`
+	Youtubeedu         *AccessRuleYoutubeEdu    `json:"youtubeEDU"`
+	Metadata           AccessRuleMetadata       `json:"metadata"`
+	Commenthistorylist []struct {
+		Comment string `json:"comment"`
+		Date    string `json:"date"`
+		User    struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	} `json:"commentHistoryList"`
 }
 
-// /fmc_config/v1/domain/DomainUUID/policy/accesspolicies/{containerUUID}/accessrules?bulk=true ( Bulk POST operation on access rules. )
+// The maximum number of access rules in one bulk create
+var access_rule_bulk_create_limit = 1000
 
+// accessRuleBatcher collects the requests for the same key which are waiting while another request for that key is
+// in flight, and sends them together once it is done. A request sent while no other is in flight is not delayed.
+type accessRuleBatcher struct {
+	mutex  sync.Mutex
+	queues map[string]*accessRuleQueue
+}
+
+type accessRuleQueue struct {
+	// Held while a request for the key is in flight
+	turn    sync.Mutex
+	pending *accessRuleBatch
+	users   int
+}
+
+type accessRuleBatch struct {
+	items   []interface{}
+	ctxs    []context.Context
+	done    chan struct{}
+	results []interface{}
+}
+
+// do adds the item to the pending batch of the key and returns its result, the first item of a batch sends the
+// whole batch with send once no other request for the key is in flight. send returns one result per item, an
+// error result is returned as the error of its item.
+func (b *accessRuleBatcher) do(ctx context.Context, key string, item interface{}, limit int, send func(ctx context.Context, items []interface{}) []interface{}) (interface{}, error) {
+	b.mutex.Lock()
+	if b.queues == nil {
+		b.queues = map[string]*accessRuleQueue{}
+	}
+	queue, ok := b.queues[key]
+	if !ok {
+		queue = &accessRuleQueue{}
+		b.queues[key] = queue
+	}
+	queue.users++
+	batch := queue.pending
+	leader := batch == nil || len(batch.items) >= limit
+	if leader {
+		batch = &accessRuleBatch{done: make(chan struct{})}
+		queue.pending = batch
+	}
+	index := len(batch.items)
+	batch.items = append(batch.items, item)
+	batch.ctxs = append(batch.ctxs, ctx)
+	b.mutex.Unlock()
+
+	if leader {
+		queue.turn.Lock()
+		b.mutex.Lock()
+		if queue.pending == batch {
+			queue.pending = nil
+		}
+		b.mutex.Unlock()
+		// The batch is sent for all its items, it is not cancelled with the context of the first one
+		batchCtx, cancel := batchContext(batch.ctxs)
+		batch.results = send(batchCtx, batch.items)
+		cancel()
+		queue.turn.Unlock()
+		close(batch.done)
+	} else {
+		<-batch.done
+	}
+
+	b.mutex.Lock()
+	queue.users--
+	if queue.users == 0 {
+		delete(b.queues, key)
+	}
+	b.mutex.Unlock()
+
+	if err, ok := batch.results[index].(error); ok {
+		return nil, err
+	}
+	return batch.results[index], nil
+}
+
+// batchContext returns a context which is cancelled once the contexts of all the items of a batch are
+func batchContext(ctxs []context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for _, c := range ctxs {
+			select {
+			case <-c.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
+// CreateFmcAccessRule creates the access rule, the rules created at the same place of the policy while another
+// create is in flight are created together with one bulk request. The rules the bulk create did not return are
+// created one by one, so that each gets its own error.
 func (v *Client) CreateFmcAccessRule(ctx context.Context, acpId, section, insertBefore, insertAfter, category string, accessPolicy *AccessRule) (*AccessRuleResponse, error) {
-	url := fmt.Sprintf("%s/policy/accesspolicies/%s/accessrules", v.domainBaseURL, acpId)
-	initialSet := false
-	if section != "" {
-		url = fmt.Sprintf("%s?section=%s", url, section)
-		initialSet = true
-	}
-	if category != "" {
-		url = fmt.Sprintf("%s?category=%s", url, category)
-		initialSet = true
-	}
-	if insertBefore != "" {
-		if initialSet {
-			url = fmt.Sprintf("%s&insertBefore=%s", url, insertBefore)
-		} else {
-			url = fmt.Sprintf("%s?insertBefore=%s", url, insertBefore)
-			initialSet = true
+	key := strings.Join([]string{acpId, section, insertBefore, insertAfter, category}, "/")
+	item, err := v.accessRuleCreates.do(ctx, key, accessPolicy, access_rule_bulk_create_limit, func(ctx context.Context, items []interface{}) []interface{} {
+		results := make([]interface{}, len(items))
+		if len(items) > 1 {
+			rules := make([]*AccessRule, 0, len(items))
+			for _, item := range items {
+				rules = append(rules, item.(*AccessRule))
+			}
+			created, err := v.createFmcAccessRules(ctx, acpId, section, insertBefore, insertAfter, category, rules)
+			if err != nil {
+				log.Printf("[WARN] %s, creating the rules one by one", err.Error())
+			}
+			for i, item := range matchCreatedAccessRules(rules, created) {
+				if item != nil {
+					results[i] = item
+				}
+			}
 		}
+		for i, item := range items {
+			if results[i] != nil {
+				continue
+			}
+			created, err := v.createFmcAccessRule(ctx, acpId, section, insertBefore, insertAfter, category, item.(*AccessRule))
+			if err != nil {
+				results[i] = err
+			} else {
+				results[i] = created
+			}
+		}
+		return results
+	})
+	if err != nil {
+		return nil, err
 	}
-	if insertAfter != "" {
-		if initialSet {
-			url = fmt.Sprintf("%s&insertAfter=%s", url, insertAfter)
-		} else {
-			url = fmt.Sprintf("%s?insertAfter=%s", url, insertAfter)
-			initialSet = true
+	return item.(*AccessRuleResponse), nil
+}
+
+// matchCreatedAccessRules returns the created rule of each rule of a bulk create, or nil if it was not returned.
+// The created rules are returned in the order of the request, when some are missing they are matched by name,
+// which is unique in an access policy.
+func matchCreatedAccessRules(rules []*AccessRule, created []AccessRuleResponse) []*AccessRuleResponse {
+	matched := make([]*AccessRuleResponse, len(rules))
+	if len(created) == len(rules) {
+		for i := range created {
+			matched[i] = &created[i]
 		}
+		return matched
 	}
+	byName := map[string]*AccessRuleResponse{}
+	for i := range created {
+		byName[created[i].Name] = &created[i]
+	}
+	for i, rule := range rules {
+		matched[i] = byName[rule.Name]
+	}
+	return matched
+}
+
+// /fmc_config/v1/domain/DomainUUID/policy/accesspolicies/{containerUUID}/accessrules?bulk=true ( Bulk POST operation on access rules. )
+
+func (v *Client) createFmcAccessRules(ctx context.Context, acpId, section, insertBefore, insertAfter, category string, accessPolicies []*AccessRule) ([]AccessRuleResponse, error) {
+	url := v.buildURL(fmt.Sprintf("policy/accesspolicies/%s/accessrules", acpId), NewQuery().
+		Set("bulk", "true").
+		Set("section", section).
+		Set("category", category).
+		Set("insertBefore", insertBefore).
+		Set("insertAfter", insertAfter))
+	body, err := json.Marshal(&accessPolicies)
+	if err != nil {
+		return nil, fmt.Errorf("creating access rules: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating access rules: %s - %s", url, err.Error())
+	}
+	res := &struct {
+		Items []AccessRuleResponse `json:"items"`
+	}{}
+	err = v.DoRequest(req, res, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating %d access rules in bulk: %s - %s", len(accessPolicies), url, err.Error())
+	}
+	if len(res.Items) != len(accessPolicies) {
+		return res.Items, fmt.Errorf("creating %d access rules in bulk: %s - %d rules returned", len(accessPolicies), url, len(res.Items))
+	}
+	return res.Items, nil
+}
+
+func (v *Client) createFmcAccessRule(ctx context.Context, acpId, section, insertBefore, insertAfter, category string, accessPolicy *AccessRule) (*AccessRuleResponse, error) {
+	url := v.buildURL(fmt.Sprintf("policy/accesspolicies/%s/accessrules", acpId), NewQuery().
+		Set("section", section).
+		Set("category", category).
+		Set("insertBefore", insertBefore).
+		Set("insertAfter", insertAfter))
 	body, err := json.Marshal(&accessPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("creating access rules: %s - %s", url, err.Error())
@@ -190,6 +466,52 @@ func (v *Client) GetFmcAccessRule(ctx context.Context, acpId string, id string)
 	return item, nil
 }
 
+type AccessRulesResponse struct {
+	Items  []AccessRuleResponse `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcAccessRules returns all the rules of the access policy in the order of their index
+func (v *Client) GetFmcAccessRules(ctx context.Context, acpId string) ([]AccessRuleResponse, error) {
+	rules := []AccessRuleResponse{}
+	for offset := 0; ; {
+		url := v.buildURL(fmt.Sprintf("policy/accesspolicies/%s/accessrules", acpId), NewQuery().Expanded(true).Offset(offset).Limit(fmc_query_limit))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting access rules: %s - %s", url, err.Error())
+		}
+		res := &AccessRulesResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting access rules: %s - %s", url, err.Error())
+		}
+		rules = append(rules, res.Items...)
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return rules, nil
+		}
+	}
+}
+
+// GetFmcAccessRuleByName returns the rule of the access policy with the given name
+func (v *Client) GetFmcAccessRuleByName(ctx context.Context, acpId, name string) (*AccessRuleResponse, error) {
+	rules, err := v.GetFmcAccessRules(ctx, acpId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		if rules[i].Name == name {
+			return &rules[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no access rule named %s found in access policy %s, please check the name", name, acpId)
+}
+
 func (v *Client) UpdateFmcAccessRule(ctx context.Context, acpId, id string, accessPolicy *AccessRuleUpdate) (*AccessRuleResponse, error) {
 	url := fmt.Sprintf("%s/policy/accesspolicies/%s/accessrules/%s", v.domainBaseURL, acpId, id)
 	body, err := json.Marshal(&accessPolicy)
@@ -208,7 +530,32 @@ func (v *Client) UpdateFmcAccessRule(ctx context.Context, acpId, id string, acce
 	return item, nil
 }
 
+// The maximum number of access rules in one bulk delete, their IDs are sent in the URL
+var access_rule_bulk_delete_limit = 50
+
+// DeleteFmcAccessRule deletes the access rule, the rules of the policy deleted while another delete is in flight
+// are deleted together with one bulk request, or one by one if the bulk delete fails
 func (v *Client) DeleteFmcAccessRule(ctx context.Context, acpId string, id string) error {
+	_, err := v.accessRuleDeletes.do(ctx, acpId, id, access_rule_bulk_delete_limit, func(ctx context.Context, items []interface{}) []interface{} {
+		ids := make([]string, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.(string))
+		}
+		results := make([]interface{}, len(ids))
+		if len(ids) > 1 && v.deleteFmcAccessRules(ctx, acpId, ids) == nil {
+			return results
+		}
+		for i, id := range ids {
+			if err := v.deleteFmcAccessRule(ctx, acpId, id); err != nil {
+				results[i] = err
+			}
+		}
+		return results
+	})
+	return err
+}
+
+func (v *Client) deleteFmcAccessRule(ctx context.Context, acpId string, id string) error {
 	url := fmt.Sprintf("%s/policy/accesspolicies/%s/accessrules/%s", v.domainBaseURL, acpId, id)
 	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
@@ -217,3 +564,20 @@ func (v *Client) DeleteFmcAccessRule(ctx context.Context, acpId string, id strin
 	err = v.DoRequest(req, nil, http.StatusOK)
 	return err
 }
+
+// /fmc_config/v1/domain/DomainUUID/policy/accesspolicies/{containerUUID}/accessrules?bulk=true&filter=ids:{ids} ( Bulk DELETE operation on access rules. )
+
+func (v *Client) deleteFmcAccessRules(ctx context.Context, acpId string, ids []string) error {
+	url := v.buildURL(fmt.Sprintf("policy/accesspolicies/%s/accessrules", acpId), NewQuery().
+		Set("bulk", "true").
+		Filter("ids", strings.Join(ids, ",")))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting access rules: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("deleting access rules: %s - %s", url, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package fmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// waitForPendingAccessRules waits until n requests for the key are waiting for the request in flight
+func waitForPendingAccessRules(t *testing.T, b *accessRuleBatcher, key string, n int) {
+	for i := 0; i < 500; i++ {
+		b.mutex.Lock()
+		pending := 0
+		if queue, ok := b.queues[key]; ok && queue.pending != nil {
+			pending = len(queue.pending.items)
+		}
+		b.mutex.Unlock()
+		if pending == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected %d pending requests for %s", n, key)
+}
+
+func TestDeleteFmcAccessRuleBulk(t *testing.T) {
+	for _, tc := range []struct {
+		bulkStatus int
+		expected   []string
+	}{
+		{http.StatusOK, []string{"DELETE /1", "DELETE bulk=true&filter=ids%3A"}},
+		{http.StatusBadRequest, []string{"DELETE /1", "DELETE bulk=true&filter=ids%3A", "DELETE /2", "DELETE /3"}},
+	} {
+		var mutex sync.Mutex
+		var requests []string
+		started, release := make(chan struct{}), make(chan struct{})
+		c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			// The first delete is in flight until the others are waiting
+			if r.URL.Path[strings.LastIndex(r.URL.Path, "/"):] == "/1" {
+				close(started)
+				<-release
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			if r.URL.RawQuery != "" {
+				requests = append(requests, r.Method+" "+r.URL.RawQuery[:len("bulk=true&filter=ids%3A")])
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.bulkStatus)
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+			requests = append(requests, r.Method+" "+r.URL.Path[strings.LastIndex(r.URL.Path, "/"):])
+		})
+
+		var wg sync.WaitGroup
+		for _, id := range []string{"1", "2", "3"} {
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				if err := c.DeleteFmcAccessRule(context.Background(), "acp", id); err != nil {
+					t.Error(err)
+				}
+			}(id)
+			if id == "1" {
+				<-started
+			}
+		}
+		waitForPendingAccessRules(t, &c.accessRuleDeletes, "acp", 2)
+		close(release)
+		wg.Wait()
+		server.Close()
+
+		if len(requests) != len(tc.expected) {
+			t.Fatalf("expected %v, got %v", tc.expected, requests)
+		}
+		for _, expected := range tc.expected {
+			found := false
+			for _, request := range requests {
+				found = found || request == expected
+			}
+			if !found {
+				t.Errorf("expected %v, got %v", tc.expected, requests)
+			}
+		}
+	}
+}
+
+func TestCreateFmcAccessRuleBulk(t *testing.T) {
+	for _, tc := range []struct {
+		bulkStatus int
+		// The names of the rules returned by the bulk create
+		bulkCreated []string
+		requests    int
+	}{
+		{http.StatusCreated, []string{"2", "3"}, 1},
+		// The rule missing from the bulk create is created alone and gets its own error
+		{http.StatusCreated, []string{"2"}, 2},
+		// The failed bulk create is retried rule by rule
+		{http.StatusBadRequest, nil, 3},
+	} {
+		var mutex sync.Mutex
+		bulkRequests, requests := 0, 0
+		started, release := make(chan struct{}), make(chan struct{})
+		c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("bulk") == "true" {
+				mutex.Lock()
+				defer mutex.Unlock()
+				bulkRequests++
+				rules := []AccessRule{}
+				if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+					t.Error(err)
+				}
+				w.WriteHeader(tc.bulkStatus)
+				if tc.bulkStatus != http.StatusCreated {
+					_, _ = w.Write([]byte(`{"error":{"messages":[{"description":"Invalid rule"}]}}`))
+					return
+				}
+				items := []string{}
+				for _, name := range tc.bulkCreated {
+					items = append(items, fmt.Sprintf(`{"id":"id-%s","name":"%s"}`, name, name))
+				}
+				_, _ = w.Write([]byte(`{"items":[` + strings.Join(items, ",") + `]}`))
+				return
+			}
+			rule := AccessRule{}
+			if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+				t.Error(err)
+			}
+			// The first create is in flight until the others are waiting
+			if rule.Name == "1" {
+				close(started)
+				<-release
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests++
+			if rule.Name == "3" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"messages":[{"description":"Invalid rule 3"}]}}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"id":"id-%s","name":"%s"}`, rule.Name, rule.Name)))
+		})
+
+		// The rule sending the batch is cancelled, the batch is still sent for the others
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+		var wg sync.WaitGroup
+		for _, name := range []string{"1", "2", "3"} {
+			ctx := context.Background()
+			if name == "2" {
+				ctx = cancelled
+			}
+			wg.Add(1)
+			go func(ctx context.Context, name string) {
+				defer wg.Done()
+				res, err := c.CreateFmcAccessRule(ctx, "acp", "mandatory", "", "", "", &AccessRule{Name: name})
+				if name == "3" && len(tc.bulkCreated) != 2 {
+					if err == nil || !strings.Contains(err.Error(), "Invalid rule 3") {
+						t.Errorf("expected the error of rule 3, got %v", err)
+					}
+				} else if err != nil {
+					t.Error(err)
+				} else if res.ID != "id-"+name {
+					t.Errorf("expected id-%s, got %s", name, res.ID)
+				}
+			}(ctx, name)
+			if name == "1" {
+				<-started
+			}
+			waitForPendingAccessRules(t, &c.accessRuleCreates, "acp/mandatory///", map[string]int{"1": 0, "2": 1, "3": 2}[name])
+		}
+		close(release)
+		wg.Wait()
+		server.Close()
+
+		if bulkRequests != 1 || requests != tc.requests {
+			t.Errorf("expected 1 bulk request and %d requests, got %d and %d", tc.requests, bulkRequests, requests)
+		}
+	}
+}
+
+func TestCreateFmcAccessRuleAlone(t *testing.T) {
+	requests := 0
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"id-1","name":"1"}`))
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.CreateFmcAccessRule(context.Background(), "acp", "mandatory", "", "", "", &AccessRule{Name: "1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 3 || len(c.accessRuleCreates.queues) != 0 {
+		t.Errorf("expected 3 requests and no queues left, got %d and %d", requests, len(c.accessRuleCreates.queues))
+	}
+}
+
+func TestGetFmcAccessRuleByName(t *testing.T) {
+	names := []string{"Legacy allow", "Legacy block", "Default block"}
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// One rule per page, to check the paging
+		offset := 0
+		_, _ = fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"items": [{"id": "%d", "name": "%s", "action": "ALLOW", "metadata": {"ruleIndex": %d, "section": "Mandatory"}}], "paging": {"count": %d}}`, offset, names[offset], offset+1, len(names))
+	})
+
+	rule, err := c.GetFmcAccessRuleByName(context.Background(), "acp", "Default block")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.ID != "2" || rule.Metadata.Ruleindex != 3 {
+		t.Errorf("expected rule 2 at index 3, got rule %s at index %d", rule.ID, rule.Metadata.Ruleindex)
+	}
+	if _, err := c.GetFmcAccessRuleByName(context.Background(), "acp", "Missing"); err == nil {
+		t.Error("expected an error for a missing rule")
+	}
+}
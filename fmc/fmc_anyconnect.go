@@ -0,0 +1,73 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type AnyConnectCustomAttribute struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Description   string `json:"description"`
+	Attributetype string `json:"attributeType"`
+}
+
+type AnyConnectCustomAttributesResponse struct {
+	Items []AnyConnectCustomAttribute `json:"items"`
+}
+
+type HostScanPackage struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type HostScanPackagesResponse struct {
+	Items []HostScanPackage `json:"items"`
+}
+
+// The AnyConnect custom attributes are read only in the FMC API (7.x), they are created in the FMC UI
+func (v *Client) GetFmcAnyConnectCustomAttributeByName(ctx context.Context, name string) (*AnyConnectCustomAttribute, error) {
+	url := v.buildURL("object/anyconnectcustomattributes", NewQuery().Expanded(true).Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting anyconnect custom attribute by name: %s - %s", url, err.Error())
+	}
+	attributes := &AnyConnectCustomAttributesResponse{}
+	err = v.DoRequest(req, attributes, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting anyconnect custom attribute by name: %s - %s", url, err.Error())
+	}
+
+	for _, attribute := range attributes.Items {
+		if attribute.Name == name {
+			return &attribute, nil
+		}
+	}
+	return nil, fmt.Errorf("no anyconnect custom attribute found with name %s", name)
+}
+
+// The HostScan packages are read only in the FMC API (7.x), they are uploaded in the FMC UI
+func (v *Client) GetFmcHostScanPackageByName(ctx context.Context, name string) (*HostScanPackage, error) {
+	url := v.buildURL("object/hostscanpackages", NewQuery().Expanded(true).Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting hostscan package by name: %s - %s", url, err.Error())
+	}
+	packages := &HostScanPackagesResponse{}
+	err = v.DoRequest(req, packages, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting hostscan package by name: %s - %s", url, err.Error())
+	}
+
+	for _, hostScanPackage := range packages.Items {
+		if hostScanPackage.Name == name {
+			return &hostScanPackage, nil
+		}
+	}
+	return nil, fmt.Errorf("no hostscan package found with name %s", name)
+}
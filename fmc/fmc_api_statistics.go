@@ -0,0 +1,66 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// APIStatistics counts the requests sent to FMC, to size the rate limit
+type APIStatistics struct {
+	Requests        int64
+	TooManyRequests int64
+	Retries         int64
+	// Nanoseconds spent waiting for the rate limiter
+	RateLimitWait int64
+}
+
+// The requests of all the clients of the provider, logged when the provider stops
+var api_statistics = &APIStatistics{}
+
+// How often the statistics are logged at DEBUG while they change. Terraform often stops reading the logs of the
+// provider before it stops, so the summary logged then may not be seen.
+var api_statistics_log_interval = 30 * time.Second
+
+var api_statistics_logging = &sync.Once{}
+
+func (s *APIStatistics) String() string {
+	return fmt.Sprintf("%d requests, %d rejected with 429 Too Many Requests, %d retries, %s waited for the rate limit",
+		atomic.LoadInt64(&s.Requests),
+		atomic.LoadInt64(&s.TooManyRequests),
+		atomic.LoadInt64(&s.Retries),
+		time.Duration(atomic.LoadInt64(&s.RateLimitWait)).Round(time.Millisecond))
+}
+
+// LogAPIStatistics logs the requests sent to FMC during the run, it is called when the provider stops
+func LogAPIStatistics() {
+	log.Printf("[INFO] FMC API statistics: %s", api_statistics)
+}
+
+// startAPIStatisticsLogging logs the statistics periodically until ctx is done, i.e. the provider is stopped.
+// It is started once, by the first configured provider, the summary is logged by main once the provider exits.
+func startAPIStatisticsLogging(ctx context.Context) {
+	api_statistics_logging.Do(func() {
+		go logAPIStatistics(ctx, api_statistics_log_interval)
+	})
+}
+
+func logAPIStatistics(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	logged := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if summary := api_statistics.String(); summary != logged {
+				logged = summary
+				log.Printf("[DEBUG] FMC API statistics: %s", summary)
+			}
+		}
+	}
+}
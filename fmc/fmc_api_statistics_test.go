@@ -0,0 +1,88 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAPIStatistics(t *testing.T) {
+	var rejected int32
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// The first request is rejected by the rate limit of FMC
+		if atomic.CompareAndSwapInt32(&rejected, 0, 1) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	requests := atomic.LoadInt64(&api_statistics.Requests)
+	tooManyRequests := atomic.LoadInt64(&api_statistics.TooManyRequests)
+	retries := atomic.LoadInt64(&api_statistics.Retries)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DoRequest(req, nil, http.StatusOK); err != nil {
+		t.Fatal(err)
+	}
+	if delta := atomic.LoadInt64(&api_statistics.Requests) - requests; delta != 2 {
+		t.Errorf("expected 2 requests, got %d", delta)
+	}
+	if delta := atomic.LoadInt64(&api_statistics.TooManyRequests) - tooManyRequests; delta != 1 {
+		t.Errorf("expected 1 request rejected with 429, got %d", delta)
+	}
+	if delta := atomic.LoadInt64(&api_statistics.Retries) - retries; delta != 1 {
+		t.Errorf("expected 1 retry, got %d", delta)
+	}
+	if !strings.Contains(api_statistics.String(), "rejected with 429") {
+		t.Errorf("unexpected summary %s", api_statistics)
+	}
+}
+
+func TestLogAPIStatistics(t *testing.T) {
+	var output bytes.Buffer
+	var mutex sync.Mutex
+	log.SetOutput(writerFunc(func(p []byte) (int, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return output.Write(p)
+	}))
+	defer log.SetOutput(os.Stderr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		logAPIStatistics(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+	atomic.AddInt64(&api_statistics.Requests, 1)
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	// The statistics are logged once while they do not change, the summary is only logged by main
+	if debug := strings.Count(output.String(), "[DEBUG] FMC API statistics"); debug != 1 {
+		t.Errorf("expected the statistics to be logged once at DEBUG, got %d times: %s", debug, output.String())
+	}
+	if strings.Contains(output.String(), "[INFO] FMC API statistics") {
+		t.Errorf("expected no summary when the provider stops, got %s", output.String())
+	}
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
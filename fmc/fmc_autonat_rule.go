@@ -129,3 +129,61 @@ func (v *Client) DeleteFmcAutoNatRule(ctx context.Context, natId string, id stri
 	err = v.DoRequest(req, nil, http.StatusOK)
 	return err
 }
+
+type AutoNatRulesResponse struct {
+	Items  []AutoNatRuleResponse `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcAutoNatRules returns all the auto nat rules of the nat policy in the order of the policy
+func (v *Client) GetFmcAutoNatRules(ctx context.Context, natId string) ([]AutoNatRuleResponse, error) {
+	rules := []AutoNatRuleResponse{}
+	for offset := 0; ; {
+		url := v.buildURL(fmt.Sprintf("policy/ftdnatpolicies/%s/autonatrules", natId), NewQuery().Expanded(true).Offset(offset).Limit(fmc_query_limit))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting auto nat rules: %s - %s", url, err.Error())
+		}
+		res := &AutoNatRulesResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting auto nat rules: %s - %s", url, err.Error())
+		}
+		rules = append(rules, res.Items...)
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return rules, nil
+		}
+	}
+}
+
+// GetFmcAutoNatRuleByDescription returns the auto nat rule of the nat policy with the given description and its index, starting at 1
+func (v *Client) GetFmcAutoNatRuleByDescription(ctx context.Context, natId, description string) (*AutoNatRuleResponse, int, error) {
+	rules, err := v.GetFmcAutoNatRules(ctx, natId)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range rules {
+		if rules[i].Description == description {
+			return &rules[i], i + 1, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("no auto nat rule with description %s found in nat policy %s, please check the description", description, natId)
+}
+
+// GetFmcAutoNatRuleByIndex returns the auto nat rule of the nat policy at the given index, starting at 1
+func (v *Client) GetFmcAutoNatRuleByIndex(ctx context.Context, natId string, index int) (*AutoNatRuleResponse, error) {
+	rules, err := v.GetFmcAutoNatRules(ctx, natId)
+	if err != nil {
+		return nil, err
+	}
+	if index < 1 || index > len(rules) {
+		return nil, fmt.Errorf("no auto nat rule at index %d in nat policy %s, it has %d auto nat rules", index, natId, len(rules))
+	}
+	return &rules[index-1], nil
+}
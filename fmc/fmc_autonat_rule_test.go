@@ -0,0 +1,41 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetFmcAutoNatRuleByDescriptionAndIndex(t *testing.T) {
+	descriptions := []string{"DNS server", "Web server", "Mail server"}
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// One rule per page, to check the paging
+		offset := 0
+		_, _ = fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"items": [{"id": "%d", "description": "%s", "natType": "STATIC", "type": "FTDAutoNatRule"}], "paging": {"count": %d}}`, offset, descriptions[offset], len(descriptions))
+	})
+
+	rule, index, err := c.GetFmcAutoNatRuleByDescription(context.Background(), "nat", "Web server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.ID != "1" || index != 2 {
+		t.Errorf("expected rule 1 at index 2, got rule %s at index %d", rule.ID, index)
+	}
+	if _, _, err := c.GetFmcAutoNatRuleByDescription(context.Background(), "nat", "Missing"); err == nil {
+		t.Error("expected an error for a missing rule")
+	}
+
+	rule, err = c.GetFmcAutoNatRuleByIndex(context.Background(), "nat", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Description != "Mail server" {
+		t.Errorf("expected the mail server rule at index 3, got %q", rule.Description)
+	}
+	if _, err := c.GetFmcAutoNatRuleByIndex(context.Background(), "nat", 4); err == nil {
+		t.Error("expected an error for an index out of range")
+	}
+}
@@ -0,0 +1,120 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// The objects which can be created with one bulk request, by type
+var bulk_object_paths = map[string]string{
+	"Network": "object/networks",
+	"Host":    "object/hosts",
+	"Range":   "object/ranges",
+}
+
+type BulkObject struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	Overridable bool   `json:"overridable"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+func bulkObjectPath(objectType string) (string, error) {
+	path, ok := bulk_object_paths[objectType]
+	if !ok {
+		return "", fmt.Errorf("objects of type %s cannot be created in bulk", objectType)
+	}
+	return path, nil
+}
+
+// CreateFmcBulkObjects creates the objects with one bulk request, FMC validates all of them before creating any, so
+// either all the objects are created or none. The created objects are returned by name.
+func (v *Client) CreateFmcBulkObjects(ctx context.Context, objectType string, objects []*BulkObject) (map[string]*BulkObject, error) {
+	path, err := bulkObjectPath(objectType)
+	if err != nil {
+		return nil, err
+	}
+	url := v.buildURL(path, NewQuery().Set("bulk", "true"))
+	body, err := json.Marshal(&objects)
+	if err != nil {
+		return nil, fmt.Errorf("creating objects in bulk: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating objects in bulk: %s - %s", url, err.Error())
+	}
+	res := &struct {
+		Items []BulkObject `json:"items"`
+	}{}
+	err = v.DoRequest(req, res, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating objects in bulk: %s - %s", url, err.Error())
+	}
+	created := map[string]*BulkObject{}
+	for i := range res.Items {
+		created[res.Items[i].Name] = &res.Items[i]
+	}
+	return created, nil
+}
+
+func (v *Client) GetFmcBulkObject(ctx context.Context, objectType, id string) (*BulkObject, error) {
+	path, err := bulkObjectPath(objectType)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/%s/%s", v.domainBaseURL, path, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting object: %s - %s", url, err.Error())
+	}
+	item := &BulkObject{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting object: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcBulkObject(ctx context.Context, objectType, id string, object *BulkObject) (*BulkObject, error) {
+	path, err := bulkObjectPath(objectType)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/%s/%s", v.domainBaseURL, path, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating object: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating object: %s - %s", url, err.Error())
+	}
+	item := &BulkObject{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating object: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcBulkObject(ctx context.Context, objectType, id string) error {
+	path, err := bulkObjectPath(objectType)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/%s/%s", v.domainBaseURL, path, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting object: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("deleting object: %s - %s", url, err.Error())
+	}
+	return nil
+}
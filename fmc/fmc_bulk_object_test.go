@@ -0,0 +1,41 @@
+package fmc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCreateFmcBulkObjects(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || !strings.HasSuffix(r.URL.Path, "/object/hosts") || r.URL.Query().Get("bulk") != "true" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		objects := []BulkObject{}
+		if err := json.NewDecoder(r.Body).Decode(&objects); err != nil {
+			t.Fatal(err)
+		}
+		for i := range objects {
+			objects[i].ID = "id-" + objects[i].Name
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": objects})
+	})
+
+	created, err := c.CreateFmcBulkObjects(context.Background(), "Host", []*BulkObject{
+		{Name: "web-1", Value: "10.10.10.11", Type: "Host"},
+		{Name: "web-2", Value: "10.10.10.12", Type: "Host"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 2 || created["web-2"].ID != "id-web-2" {
+		t.Errorf("unexpected objects %+v", created)
+	}
+	if _, err := c.CreateFmcBulkObjects(context.Background(), "FQDN", nil); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+}
@@ -1,13 +1,17 @@
 package fmc
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/juju/ratelimit"
@@ -33,6 +37,22 @@ type Client struct {
 	ratelimiterBucket *ratelimit.Bucket
 	nonReadMutex      *sync.Mutex
 	callSemaphore     semaphore
+	// Fail on the fields of the responses not known to the provider, see the fmc_strict_decoding provider option
+	strictDecoding bool
+	// The version of FMC, read once by requireFmcVersion
+	serverVersion      *ServerVersion
+	serverVersionMutex *sync.Mutex
+	// Marker appended to the descriptions of the objects created by terraform, see the fmc_description_marker provider option
+	descriptionMarker string
+	// Comment added to the rules changed by terraform without new comments, see the fmc_default_comment provider option
+	defaultCommentTemplate string
+	// Names of the objects in this FMC, keyed by the names used in the configuration, see the fmc_name_mapping provider option
+	nameMapping map[string]string
+	// Client of the read-only user sending the GET requests, see the fmc_read_only_username provider option
+	reader *Client
+	// The access rules created and deleted together in bulk
+	accessRuleCreates accessRuleBatcher
+	accessRuleDeletes accessRuleBatcher
 }
 
 type ErrorResponse struct {
@@ -45,25 +65,43 @@ type ErrorResponse struct {
 	} `json:"error"`
 }
 
+// The error of the requests to endpoints which do not exist in the FMC version
+var fmc_missing_endpoint_message = "the endpoint does not exist in this FMC"
+
+// Headers FMC and the proxies in front of it use to identify a request, useful when opening TAC cases
+var requestIDHeaders = []string{"X-Request-Id", "X-Transaction-Id", "X-Correlation-Id", "X-Trace-Id"}
+
+// requestIDs returns the request identifiers found in the response headers, formatted for error messages
+func requestIDs(r *http.Response) string {
+	ids := ""
+	for _, header := range requestIDHeaders {
+		if id := r.Header.Get(header); id != "" {
+			ids += fmt.Sprintf("%s: %s, ", header, id)
+		}
+	}
+	return ids
+}
+
 func NewClient(user, password, host string, insecureSkipVerify bool) *Client {
 	return &Client{
 		user:     user,
 		password: password,
 		host:     host,
-		client: &http.Client{Transport: &http.Transport{
+		client: &http.Client{Transport: withFixtures(&http.Transport{
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: insecureSkipVerify,
 			},
-		}},
-		ratelimiterBucket: rateLimiterBucket,
-		nonReadMutex:      nonReadMutex,
-		callSemaphore:     callSemaphore,
+		})},
+		ratelimiterBucket:  rateLimiterBucket,
+		nonReadMutex:       nonReadMutex,
+		callSemaphore:      callSemaphore,
+		serverVersionMutex: &sync.Mutex{},
 	}
 }
 
-func (v *Client) Login() error {
+func (v *Client) Login(ctx context.Context) error {
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("https://%s/api/fmc_platform/v1/auth/generatetoken", v.host), nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("https://%s/api/fmc_platform/v1/auth/generatetoken", v.host), nil)
 	if err != nil {
 		return (err)
 	}
@@ -88,16 +126,122 @@ func (v *Client) Login() error {
 	return nil
 }
 
+// waitContext waits for the duration, returning early with the error of the context if it is cancelled
+func waitContext(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type ReadOnlyResponse struct {
+	Metadata struct {
+		Readonly struct {
+			State  bool   `json:"state"`
+			Reason string `json:"reason"`
+		} `json:"readOnly"`
+		Domain struct {
+			Name string `json:"name"`
+		} `json:"domain"`
+	} `json:"metadata"`
+}
+
+// UnmarshalJSON ignores the unknown fields even with strict decoding, only the metadata of the object is read
+func (r *ReadOnlyResponse) UnmarshalJSON(data []byte) error {
+	type readOnlyResponse ReadOnlyResponse
+	return json.Unmarshal(data, (*readOnlyResponse)(r))
+}
+
+// Marks the context of the read-only checks, which are not checked again if they fail
+type readOnlyCheckKey struct{}
+
+// readOnlyReason explains why the object changed by the request is read-only, or returns "" if it is not. The
+// object is read with the client which sent the request, not with the reader, as it is the one rejected.
+func (v *Client) readOnlyReason(req *http.Request) string {
+	if req.Context().Value(readOnlyCheckKey{}) != nil {
+		return ""
+	}
+	url := *req.URL
+	url.RawQuery = ""
+	check, err := http.NewRequestWithContext(context.WithValue(req.Context(), readOnlyCheckKey{}, true), "GET", url.String(), nil)
+	if err != nil {
+		log.Printf("[WARN] checking if %s is read-only: %s", url.String(), err.Error())
+		return ""
+	}
+	item := &ReadOnlyResponse{}
+	if err := v.doRequest(check, item, http.StatusOK, false); err != nil {
+		log.Printf("[WARN] checking if %s is read-only: %s", url.String(), err.Error())
+		return ""
+	}
+	if !item.Metadata.Readonly.State {
+		return ""
+	}
+	switch strings.ToUpper(item.Metadata.Readonly.Reason) {
+	case "SYSTEM":
+		return "it is system-defined"
+	case "DOMAIN":
+		return fmt.Sprintf("it is owned by the domain %s and can only be changed there", item.Metadata.Domain.Name)
+	case "RBAC":
+		return "the user is not allowed to change it"
+	}
+	return fmt.Sprintf("reason: %s", item.Metadata.Readonly.Reason)
+}
+
 func (v *Client) DoRequest(req *http.Request, item interface{}, status int) error {
+	if v.reader != nil && req.Method == "GET" {
+		return v.reader.DoRequest(req, item, status)
+	}
+	return v.doRequest(req, item, status, false)
+}
+
+// isInvalidSession checks if FMC rejected the request because the access token was invalidated, which happens
+// when another login for the same user races with this one
+func isInvalidSession(statusCode int, body []byte) bool {
+	if statusCode == http.StatusUnauthorized {
+		return true
+	}
+	lowerBody := strings.ToLower(string(body))
+	return strings.Contains(lowerBody, "invalid session") || strings.Contains(lowerBody, "access token invalid")
+}
+
+// resetBody rewinds the body of the request so it can be sent again
+func resetBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+func (v *Client) doRequest(req *http.Request, item interface{}, status int, relogged bool) error {
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("X-Auth-Access-Token", v.accessToken)
 
-	v.ratelimiterBucket.Wait(1) // This is a blocking call. Honors the rate limit by taking 1 token for this request.
+	// Honors the rate limit by taking 1 token for this request, waiting for it unless the request is cancelled
+	wait := v.ratelimiterBucket.Take(1)
+	atomic.AddInt64(&api_statistics.RateLimitWait, int64(wait))
+	if err := waitContext(req.Context(), wait); err != nil {
+		return err
+	}
 
 	var r *http.Response
 	var err error
 
-	v.callSemaphore.Lock()
+	if err := v.callSemaphore.LockContext(req.Context()); err != nil {
+		return err
+	}
+	atomic.AddInt64(&api_statistics.Requests, 1)
 	if req.Method == "GET" {
 		r, err = v.client.Do(req)
 	} else {
@@ -115,37 +259,75 @@ func (v *Client) DoRequest(req *http.Request, item interface{}, status int) erro
 		status = http.StatusOK
 	}
 
-	// Handle 401 by logging in again
-	if r.StatusCode == http.StatusUnauthorized {
-		v.Login()
-		return v.DoRequest(req, item, status)
-	}
-
 	// Handle 429 by sending it again, will go through the same token rate limiter
 	if r.StatusCode == http.StatusTooManyRequests {
-		return v.DoRequest(req, item, status)
+		atomic.AddInt64(&api_statistics.TooManyRequests, 1)
+		atomic.AddInt64(&api_statistics.Retries, 1)
+		r.Body.Close()
+		if err := resetBody(req); err != nil {
+			return err
+		}
+		return v.doRequest(req, item, status, relogged)
 	}
 
 	if r.StatusCode != status {
 		defer r.Body.Close()
 
-		errorRes := ErrorResponse{}
-		err = json.NewDecoder(r.Body).Decode(&errorRes)
+		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
-			if body, err := ioutil.ReadAll(r.Body); err != nil {
-				return fmt.Errorf("wrong status code: %d, could not read error body as error json and string, headers: %+v", r.StatusCode, r.Header)
-			} else {
-				return fmt.Errorf("wrong status code: %d, could not read error body as error json, body: %s, headers: %+v", r.StatusCode, body, r.Header)
+			return fmt.Errorf("wrong status code: %d, %s %s, %scould not read error body, headers: %+v", r.StatusCode, req.Method, req.URL, requestIDs(r), r.Header)
+		}
+
+		// Handle an invalidated session by logging in again and retrying once
+		if !relogged && isInvalidSession(r.StatusCode, body) {
+			log.Printf("Session invalidated with status code %d, logging in again", r.StatusCode)
+			if err := v.Login(req.Context()); err != nil {
+				return fmt.Errorf("logging in again after session was invalidated: %s", err.Error())
+			}
+			if err := resetBody(req); err != nil {
+				return err
+			}
+			atomic.AddInt64(&api_statistics.Retries, 1)
+			return v.doRequest(req, item, status, true)
+		}
+
+		// Explain changes rejected because the object is read-only instead of returning the error of FMC as is. Only
+		// updates and deletes of one object are checked, the URLs of creates and bulk requests are not an object.
+		if (req.Method == "PUT" || req.Method == "DELETE") && req.URL.Query().Get("bulk") == "" && (r.StatusCode == http.StatusBadRequest || r.StatusCode == http.StatusForbidden || r.StatusCode == http.StatusUnprocessableEntity) {
+			if readOnly := v.readOnlyReason(req); readOnly != "" {
+				return fmt.Errorf("%s %s is read-only in FMC, %s, status code: %d, body: %s", req.Method, req.URL, readOnly, r.StatusCode, body)
 			}
 		}
-		return fmt.Errorf("wrong status code: %d, error category: %s, error severity: %s, error messages: %v", r.StatusCode, errorRes.Error.Category, errorRes.Error.Severity, errorRes.Error.Messages)
+
+		errorRes := ErrorResponse{}
+		if err := json.Unmarshal(body, &errorRes); err != nil {
+			// Endpoints missing in older FMC versions return the HTML page of the web server instead of an error
+			if r.StatusCode == http.StatusNotFound && strings.Contains(r.Header.Get("Content-Type"), "text/html") {
+				return fmt.Errorf("wrong status code: %d, %s %s, %s%s, it may be unsupported on this FMC version", r.StatusCode, req.Method, req.URL, requestIDs(r), fmc_missing_endpoint_message)
+			}
+			return fmt.Errorf("wrong status code: %d, %s %s, %scould not read error body as error json, body: %s, headers: %+v", r.StatusCode, req.Method, req.URL, requestIDs(r), body, r.Header)
+		}
+		return fmt.Errorf("wrong status code: %d, %s %s, %serror category: %s, error severity: %s, error messages: %v, body: %s", r.StatusCode, req.Method, req.URL, requestIDs(r), errorRes.Error.Category, errorRes.Error.Severity, errorRes.Error.Messages, body)
 	}
 	log.Printf("Status code: %d", r.StatusCode)
 
+	// Downloads are written as they are instead of decoding them
+	if w, ok := item.(io.Writer); ok {
+		defer r.Body.Close()
+		_, err = io.Copy(w, r.Body)
+		return err
+	}
 	if item != nil {
 		defer r.Body.Close()
-		err = json.NewDecoder(r.Body).Decode(item)
+		decoder := json.NewDecoder(r.Body)
+		if v.strictDecoding {
+			decoder.DisallowUnknownFields()
+		}
+		err = decoder.Decode(item)
 		if err != nil {
+			if v.strictDecoding {
+				return fmt.Errorf("decoding response of %s %s in strict mode, %sthe provider does not know a field returned by FMC: %s", req.Method, req.URL, requestIDs(r), err.Error())
+			}
 			return err
 		}
 	}
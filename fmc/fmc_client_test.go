@@ -0,0 +1,178 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReadOnlyReason(t *testing.T) {
+	checks := 0
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "GET" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"category":"FRAMEWORK","messages":[{"description":"Action not allowed"}],"severity":"ERROR"}}`))
+			return
+		}
+		checks++
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/accesspolicies/global"):
+			_, _ = w.Write([]byte(`{"id":"global","metadata":{"readOnly":{"state":true,"reason":"DOMAIN"},"domain":{"name":"Global"}}}`))
+		case strings.HasSuffix(r.URL.Path, "/networks/any"):
+			_, _ = w.Write([]byte(`{"id":"any","metadata":{"readOnly":{"state":true,"reason":"SYSTEM"}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"1","metadata":{}}`))
+		}
+	})
+	base := server.URL + "/api/fmc_config/v1/domain/default"
+
+	for _, tc := range []struct {
+		method   string
+		url      string
+		expected string
+		checks   int
+	}{
+		{"PUT", base + "/object/networks/any", "is read-only in FMC, it is system-defined", 1},
+		{"DELETE", base + "/object/networks/any", "is read-only in FMC, it is system-defined", 1},
+		{"PUT", base + "/policy/accesspolicies/global", "is read-only in FMC, it is owned by the domain Global", 1},
+		{"PUT", base + "/object/networks/1", "error messages: [{Action not allowed}]", 1},
+		// The URLs of creates and bulk requests are not an object, the error of FMC is returned as is
+		{"POST", base + "/object/networks", "error messages: [{Action not allowed}]", 0},
+		{"POST", base + "/policy/accesspolicies/global/accessrules?section=mandatory", "error messages: [{Action not allowed}]", 0},
+		{"DELETE", base + "/policy/accesspolicies/global/accessrules?bulk=true&filter=ids%3A1", "error messages: [{Action not allowed}]", 0},
+	} {
+		checks = 0
+		req, err := http.NewRequestWithContext(context.Background(), tc.method, tc.url, strings.NewReader("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = c.DoRequest(req, nil, http.StatusOK)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("%s %s: expected %q, got %v", tc.method, tc.url, tc.expected, err)
+		}
+		if checks != tc.checks {
+			t.Errorf("%s %s: expected %d read-only checks, got %d", tc.method, tc.url, tc.checks, checks)
+		}
+	}
+}
+
+func TestReadOnlyReasonLookup(t *testing.T) {
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	var tokens []string
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		tokens = append(tokens, r.Header.Get("X-Auth-Access-Token"))
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"category":"FRAMEWORK","messages":[{"description":"Action not allowed"}],"severity":"ERROR"}}`))
+	})
+	c.accessToken = "writer"
+	c.reader = NewClient("reader", "password", strings.TrimPrefix(server.URL, "https://"), true)
+	c.reader.accessToken = "reader"
+
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", server.URL+"/object/networks/1", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DoRequest(req, nil, http.StatusOK); err == nil || !strings.Contains(err.Error(), "error messages: [{Action not allowed}]") {
+		t.Errorf("expected the error of FMC, got %v", err)
+	}
+	// The failed check is sent once, by the client which sent the request, and logged
+	if len(tokens) != 2 || tokens[1] != "writer" {
+		t.Errorf("expected one check by the writer, got %v", tokens)
+	}
+	if !strings.Contains(output.String(), "[WARN] checking if "+server.URL+"/object/networks/1 is read-only") {
+		t.Errorf("expected the failed check to be logged, got %s", output.String())
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "object", "name": "Object", "addedInNewerVersion": true}`))
+	})
+
+	for _, strict := range []bool{false, true} {
+		c.strictDecoding = strict
+		req, err := http.NewRequestWithContext(context.Background(), "GET", server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		item := &struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		}{}
+		err = c.DoRequest(req, item, http.StatusOK)
+		if strict && (err == nil || !strings.Contains(err.Error(), "addedInNewerVersion")) {
+			t.Errorf("expected the unknown field to be reported in strict mode, got %v", err)
+		}
+		if !strict && (err != nil || item.ID != "object") {
+			t.Errorf("expected the unknown field to be ignored, got %+v, %v", item, err)
+		}
+	}
+}
+
+func TestStrictDecodingPartialResponses(t *testing.T) {
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/serverversion") {
+			_, _ = w.Write([]byte(`{"items": [{"serverVersion": "7.2.0 (build 82)", "addedInNewerVersion": true}], "links": {}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "object", "name": "Object", "metadata": {"readOnly": {"state": true, "reason": "SYSTEM"}, "timestamp": 0}}`))
+	})
+	c.strictDecoding = true
+
+	// The lookups of the provider only read a part of the responses, they do not fail in strict mode
+	if err := c.requireFmcVersion(context.Background(), "fmc_feature", "7.1"); err != nil {
+		t.Errorf("expected the version check to pass in strict mode, got %v", err)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", server.URL+"/object", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reason := c.readOnlyReason(req); reason != "it is system-defined" {
+		t.Errorf("expected the read-only reason in strict mode, got %q", reason)
+	}
+	rule := &AccessRuleResponse{}
+	decoder := json.NewDecoder(strings.NewReader(`{"metadata": {"ruleIndex": 3, "section": "Mandatory", "timestamp": 0}}`))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(rule); err != nil || rule.Metadata.Ruleindex != 3 {
+		t.Errorf("expected the metadata of the rule to be read leniently, got %+v, %v", rule.Metadata, err)
+	}
+}
+
+func TestReadOnlyUser(t *testing.T) {
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expected := "write"
+		if r.Method == "GET" {
+			expected = "read"
+		}
+		if token := r.Header.Get("X-Auth-Access-Token"); token != expected {
+			t.Errorf("%s sent with the token %q, expected %q", r.Method, token, expected)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	})
+	c.accessToken = "write"
+	c.reader = NewClient("auditor", "password", strings.TrimPrefix(server.URL, "https://"), true)
+	c.reader.accessToken = "read"
+
+	for _, method := range []string{"GET", "PUT"} {
+		req, err := http.NewRequestWithContext(context.Background(), method, server.URL, strings.NewReader("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.DoRequest(req, nil, http.StatusOK); err != nil {
+			t.Error(err)
+		}
+	}
+}
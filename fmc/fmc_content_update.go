@@ -0,0 +1,41 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ContentUpdate struct {
+	Type    string `json:"type"`
+	Install bool   `json:"install"`
+}
+
+type ContentUpdateResponse struct {
+	Metadata struct {
+		Task struct {
+			ID string `json:"id"`
+		} `json:"task"`
+	} `json:"metadata"`
+}
+
+// Content updates are part of the platform API, they do not depend on the domain
+func (v *Client) CreateFmcContentUpdate(ctx context.Context, object *ContentUpdate) (*ContentUpdateResponse, error) {
+	url := fmt.Sprintf("https://%s/api/fmc_platform/v1/updates/contentupdates", v.host)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating content: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating content: %s - %s", url, err.Error())
+	}
+	item := &ContentUpdateResponse{}
+	err = v.DoRequest(req, item, http.StatusAccepted)
+	if err != nil {
+		return nil, fmt.Errorf("updating content: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
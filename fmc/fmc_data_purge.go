@@ -0,0 +1,81 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// The object types which can be purged, groups first so that their members are no longer in use when deleted
+var data_purge_object_types = []string{
+	"NetworkGroup",
+	"PortObjectGroup",
+	"UrlGroup",
+	"Network",
+	"Host",
+	"Range",
+	"FQDN",
+	"ProtocolPortObject",
+	"ICMPV4Object",
+	"Url",
+}
+
+type PurgeObjectsResponse struct {
+	Items  []AccessRuleResponseObject `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcObjectsByNamePrefix returns the objects of the given type in the domain whose names start with the prefix
+func (v *Client) GetFmcObjectsByNamePrefix(ctx context.Context, objectType, prefix string) ([]AccessRuleResponseObject, error) {
+	path, ok := object_reference_paths[objectType]
+	if !ok {
+		return nil, fmt.Errorf("getting objects by name prefix: unknown object type %s", objectType)
+	}
+	objects := []AccessRuleResponseObject{}
+	for offset := 0; ; {
+		// FMC only filters by substring, the prefix is checked below
+		url := v.buildURL(path, NewQuery().Offset(offset).Limit(fmc_query_limit).Filter("nameOrValue", prefix))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting objects by name prefix: %s - %s", url, err.Error())
+		}
+		res := &PurgeObjectsResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting objects by name prefix: %s - %s", url, err.Error())
+		}
+		for _, object := range res.Items {
+			if strings.HasPrefix(object.Name, prefix) {
+				objects = append(objects, object)
+			}
+		}
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return objects, nil
+		}
+	}
+}
+
+// DeleteFmcObject deletes the object of the given type and ID
+func (v *Client) DeleteFmcObject(ctx context.Context, objectType, id string) error {
+	path, ok := object_reference_paths[objectType]
+	if !ok {
+		return fmt.Errorf("deleting object: unknown object type %s", objectType)
+	}
+	url := fmt.Sprintf("%s/%s/%s", v.domainBaseURL, path, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting object: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("deleting object: %s - %s", url, err.Error())
+	}
+	return nil
+}
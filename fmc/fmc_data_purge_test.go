@@ -0,0 +1,36 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetFmcObjectsByNamePrefix(t *testing.T) {
+	names := []string{"ci-1-web", "ci-1-db", "prod-ci-1-web", "ci-2-web"}
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// One object per page, to check the paging
+		offset := 0
+		_, _ = fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"items": [{"id": "%d", "name": "%s", "type": "Host"}], "paging": {"count": %d}}`, offset, names[offset], len(names))
+	})
+
+	objects, err := c.GetFmcObjectsByNamePrefix(context.Background(), "Host", "ci-1-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, object := range objects {
+		got = append(got, object.Name)
+	}
+	if expected := []string{"ci-1-web", "ci-1-db"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected objects %v, got %v", expected, got)
+	}
+
+	if _, err := c.GetFmcObjectsByNamePrefix(context.Background(), "Unknown", "ci-1-"); err == nil {
+		t.Error("expected an error for an unknown object type")
+	}
+}
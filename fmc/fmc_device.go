@@ -1,7 +1,9 @@
 package fmc
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -32,8 +34,9 @@ type Device struct {
 	Name string
 }
 
+// The devices are filtered by FMC, which also returns partial matches of the name, so the name is still compared
 func (v *Client) GetFmcDeviceByName(ctx context.Context, name string) (*Device, error) {
-	url := fmt.Sprintf("%s/devices/devicerecords", v.domainBaseURL)
+	url := v.buildURL("devices/devicerecords", NewQuery().Limit(fmc_query_limit).Filter("name", name))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting device by name: %s - %s", url, err.Error())
@@ -55,3 +58,168 @@ func (v *Client) GetFmcDeviceByName(ctx context.Context, name string) (*Device,
 	}
 	return nil, fmt.Errorf("no device found with name %s", name)
 }
+
+type DeviceSubConfig struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type DeviceRegistration struct {
+	ID              string           `json:"id,omitempty"`
+	Type            string           `json:"type"`
+	Name            string           `json:"name"`
+	Hostname        string           `json:"hostName,omitempty"`
+	Regkey          string           `json:"regKey,omitempty"`
+	Natid           string           `json:"natID,omitempty"`
+	Licensecaps     []string         `json:"license_caps,omitempty"`
+	Performancetier string           `json:"performanceTier,omitempty"`
+	Accesspolicy    *DeviceSubConfig `json:"accessPolicy,omitempty"`
+}
+
+type DeviceRegistrationResponse struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Metadata struct {
+		Task struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"task"`
+	} `json:"metadata"`
+}
+
+type DeviceResponse struct {
+	ID              string   `json:"id"`
+	Type            string   `json:"type"`
+	Name            string   `json:"name"`
+	Hostname        string   `json:"hostName"`
+	Model           string   `json:"model"`
+	Sw_version      string   `json:"sw_version"`
+	Healthstatus    string   `json:"healthStatus"`
+	Licensecaps     []string `json:"license_caps"`
+	Performancetier string   `json:"performanceTier"`
+	Accesspolicy    struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"accessPolicy"`
+}
+
+// Device registration is asynchronous, FMC accepts the request and returns a task to track the registration
+func (v *Client) CreateFmcDevice(ctx context.Context, device *DeviceRegistration) (*DeviceRegistrationResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords", v.domainBaseURL)
+	body, err := json.Marshal(&device)
+	if err != nil {
+		return nil, fmt.Errorf("registering device: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("registering device: %s - %s", url, err.Error())
+	}
+	item := &DeviceRegistrationResponse{}
+	err = v.DoRequest(req, item, http.StatusAccepted)
+	if err != nil {
+		return nil, fmt.Errorf("registering device: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcDevice(ctx context.Context, id string) (*DeviceResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting device: %s - %s", url, err.Error())
+	}
+	item := &DeviceResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting device: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcDevice(ctx context.Context, id string, device *DeviceRegistration) (*DeviceResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&device)
+	if err != nil {
+		return nil, fmt.Errorf("updating device: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating device: %s - %s", url, err.Error())
+	}
+	item := &DeviceResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating device: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcDevice(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting device: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
+
+type DeviceLicenseUpdate struct {
+	ID          string   `json:"id"`
+	Type        string   `json:"type"`
+	Name        string   `json:"name"`
+	Licensecaps []string `json:"license_caps"`
+}
+
+func (v *Client) GetFmcDeviceBySerialNumber(ctx context.Context, serialNumber string) (*Device, error) {
+	url := v.buildURL("devices/devicerecords", NewQuery().Expanded(true).Limit(fmc_query_limit).Filter("serialNumber", serialNumber))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting device by serial number: %s - %s", url, err.Error())
+	}
+	devices := &struct {
+		Items []struct {
+			ID       string `json:"id"`
+			Type     string `json:"type"`
+			Name     string `json:"name"`
+			Metadata struct {
+				Deviceserialnumber string `json:"deviceSerialNumber"`
+			} `json:"metadata"`
+		} `json:"items"`
+	}{}
+	err = v.DoRequest(req, devices, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting device by serial number: %s - %s", url, err.Error())
+	}
+
+	for _, device := range devices.Items {
+		if device.Metadata.Deviceserialnumber == serialNumber {
+			return &Device{
+				ID:   device.ID,
+				Name: device.Name,
+				Type: device.Type,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no device found with serial number %s", serialNumber)
+}
+
+func (v *Client) UpdateFmcDeviceLicenses(ctx context.Context, id string, device *DeviceLicenseUpdate) (*DeviceResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&device)
+	if err != nil {
+		return nil, fmt.Errorf("updating device licenses: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating device licenses: %s - %s", url, err.Error())
+	}
+	item := &DeviceResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating device licenses: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
@@ -0,0 +1,65 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type DeviceClusterNode struct {
+	Devicedetails struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"deviceDetails"`
+}
+
+type DeviceClusterResponse struct {
+	ID            string              `json:"id"`
+	Type          string              `json:"type"`
+	Name          string              `json:"name"`
+	Controldevice DeviceClusterNode   `json:"controlDevice"`
+	Datadevices   []DeviceClusterNode `json:"dataDevices"`
+}
+
+type DeviceClustersResponse struct {
+	Items []struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"items"`
+}
+
+func (v *Client) GetFmcDeviceClusterByName(ctx context.Context, name string) (*DeviceClusterResponse, error) {
+	url := v.buildURL("deviceclusters/ftddevicecluster", NewQuery().Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting device cluster by name: %s - %s", url, err.Error())
+	}
+	clusters := &DeviceClustersResponse{}
+	err = v.DoRequest(req, clusters, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting device cluster by name: %s - %s", url, err.Error())
+	}
+
+	for _, cluster := range clusters.Items {
+		if cluster.Name == name {
+			return v.GetFmcDeviceCluster(ctx, cluster.ID)
+		}
+	}
+	return nil, fmt.Errorf("no device cluster found with name %s", name)
+}
+
+func (v *Client) GetFmcDeviceCluster(ctx context.Context, id string) (*DeviceClusterResponse, error) {
+	url := fmt.Sprintf("%s/deviceclusters/ftddevicecluster/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting device cluster: %s - %s", url, err.Error())
+	}
+	item := &DeviceClusterResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting device cluster: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
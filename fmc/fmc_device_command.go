@@ -0,0 +1,40 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type DeviceCommandResponse struct {
+	Items []struct {
+		Commandinput  string `json:"commandInput"`
+		Commandoutput string `json:"commandOutput"`
+	} `json:"items"`
+}
+
+// Runs a command on the device, FMC (7.1+) only allows a list of show commands
+func (v *Client) GetFmcDeviceCommandOutput(ctx context.Context, deviceID, command string) (string, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("running device command: no command given")
+	}
+	url := v.buildURL(fmt.Sprintf("devices/devicerecords/%s/operational/commands", deviceID), NewQuery().
+		Set("command", fields[0]).
+		Set("parameters", strings.Join(fields[1:], " ")))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("running device command: %s - %s", url, err.Error())
+	}
+	res := &DeviceCommandResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return "", fmt.Errorf("running device command: %s - %s", url, err.Error())
+	}
+	outputs := make([]string, 0, len(res.Items))
+	for _, item := range res.Items {
+		outputs = append(outputs, item.Commandoutput)
+	}
+	return strings.Join(outputs, "\n"), nil
+}
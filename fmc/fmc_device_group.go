@@ -0,0 +1,104 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type DeviceGroupMember struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+type DeviceGroup struct {
+	ID      string              `json:"id,omitempty"`
+	Name    string              `json:"name"`
+	Type    string              `json:"type"`
+	Members []DeviceGroupMember `json:"members"`
+}
+
+func (v *Client) CreateFmcDeviceGroup(ctx context.Context, object *DeviceGroup) (*DeviceGroup, error) {
+	url := fmt.Sprintf("%s/devicegroups/devicegrouprecords", v.domainBaseURL)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating device group: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating device group: %s - %s", url, err.Error())
+	}
+	item := &DeviceGroup{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating device group: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcDeviceGroup(ctx context.Context, id string) (*DeviceGroup, error) {
+	url := fmt.Sprintf("%s/devicegroups/devicegrouprecords/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting device group: %s - %s", url, err.Error())
+	}
+	item := &DeviceGroup{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting device group: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcDeviceGroup(ctx context.Context, id string, object *DeviceGroup) (*DeviceGroup, error) {
+	url := fmt.Sprintf("%s/devicegroups/devicegrouprecords/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating device group: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating device group: %s - %s", url, err.Error())
+	}
+	item := &DeviceGroup{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating device group: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcDeviceGroup(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/devicegroups/devicegrouprecords/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting device group: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("deleting device group: %s - %s", url, err.Error())
+	}
+	return nil
+}
+
+// GetFmcDeviceGroupsMembers returns the members of the device groups, each member once in the order of the groups
+func (v *Client) GetFmcDeviceGroupsMembers(ctx context.Context, ids []string) ([]DeviceGroupMember, error) {
+	members := []DeviceGroupMember{}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		group, err := v.GetFmcDeviceGroup(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range group.Members {
+			if !seen[member.ID] {
+				seen[member.ID] = true
+				members = append(members, member)
+			}
+		}
+	}
+	return members, nil
+}
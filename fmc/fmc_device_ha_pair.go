@@ -0,0 +1,71 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type DeviceHAPairDevice struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type DeviceHAPairStatus struct {
+	Currentstatus string `json:"currentStatus"`
+}
+
+type DeviceHAPairResponse struct {
+	ID        string             `json:"id"`
+	Type      string             `json:"type"`
+	Name      string             `json:"name"`
+	Primary   DeviceHAPairDevice `json:"primary"`
+	Secondary DeviceHAPairDevice `json:"secondary"`
+	Metadata  struct {
+		Primarystatus   DeviceHAPairStatus `json:"primaryStatus"`
+		Secondarystatus DeviceHAPairStatus `json:"secondaryStatus"`
+	} `json:"metadata"`
+}
+
+type DeviceHAPairsResponse struct {
+	Items []struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"items"`
+}
+
+func (v *Client) GetFmcDeviceHAPairByName(ctx context.Context, name string) (*DeviceHAPairResponse, error) {
+	url := v.buildURL("devicehapairs/ftddevicehapairs", NewQuery().Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting device HA pair by name: %s - %s", url, err.Error())
+	}
+	haPairs := &DeviceHAPairsResponse{}
+	err = v.DoRequest(req, haPairs, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting device HA pair by name: %s - %s", url, err.Error())
+	}
+
+	for _, haPair := range haPairs.Items {
+		if haPair.Name == name {
+			return v.GetFmcDeviceHAPair(ctx, haPair.ID)
+		}
+	}
+	return nil, fmt.Errorf("no device HA pair found with name %s", name)
+}
+
+func (v *Client) GetFmcDeviceHAPair(ctx context.Context, id string) (*DeviceHAPairResponse, error) {
+	url := fmt.Sprintf("%s/devicehapairs/ftddevicehapairs/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting device HA pair: %s - %s", url, err.Error())
+	}
+	item := &DeviceHAPairResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting device HA pair: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
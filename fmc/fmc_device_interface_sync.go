@@ -0,0 +1,60 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Actions on the interface events of a device
+var (
+	interface_events_sync   string = "SYNC_WITH_DEVICE"
+	interface_events_accept string = "ACCEPT_CHANGES"
+)
+
+type InterfaceEventsAction struct {
+	Action string `json:"action"`
+}
+
+type InterfaceEvent struct {
+	Name       string `json:"name"`
+	Changetype string `json:"changeType"`
+}
+
+type InterfaceEventsResponse struct {
+	Items []InterfaceEvent `json:"items"`
+}
+
+// The interface events are the changes of the interfaces found on the device since the last sync
+func (v *Client) GetFmcInterfaceEvents(ctx context.Context, deviceID string) ([]InterfaceEvent, error) {
+	url := v.buildURL(fmt.Sprintf("devices/devicerecords/%s/operational/interfaceevents", deviceID), NewQuery().Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting interface events: %s - %s", url, err.Error())
+	}
+	res := &InterfaceEventsResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting interface events: %s - %s", url, err.Error())
+	}
+	return res.Items, nil
+}
+
+func (v *Client) UpdateFmcInterfaceEvents(ctx context.Context, deviceID, action string) error {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/operational/interfaceevents", v.domainBaseURL, deviceID)
+	body, err := json.Marshal(&InterfaceEventsAction{Action: action})
+	if err != nil {
+		return fmt.Errorf("updating interface events: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("updating interface events: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusAccepted)
+	if err != nil {
+		return fmt.Errorf("updating interface events: %s - %s", url, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type PhysicalInterfacesResponse struct {
+	Items []struct {
+		ID     string `json:"id"`
+		Type   string `json:"type"`
+		Name   string `json:"name"`
+		Ifname string `json:"ifname"`
+	} `json:"items"`
+}
+
+type PhysicalInterface struct {
+	ID     string
+	Type   string
+	Name   string
+	Ifname string
+}
+
+func (v *Client) GetFmcPhysicalInterfaces(ctx context.Context, deviceID string) ([]PhysicalInterface, error) {
+	url := v.buildURL(fmt.Sprintf("devices/devicerecords/%s/physicalinterfaces", deviceID), NewQuery().Expanded(true).Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting physical interfaces: %s - %s", url, err.Error())
+	}
+	res := &PhysicalInterfacesResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting physical interfaces: %s - %s", url, err.Error())
+	}
+
+	interfaces := make([]PhysicalInterface, 0, len(res.Items))
+	for _, physicalInterface := range res.Items {
+		interfaces = append(interfaces, PhysicalInterface{
+			ID:     physicalInterface.ID,
+			Type:   physicalInterface.Type,
+			Name:   physicalInterface.Name,
+			Ifname: physicalInterface.Ifname,
+		})
+	}
+	return interfaces, nil
+}
+
+func (v *Client) GetFmcPhysicalInterfaceByName(ctx context.Context, deviceID, name string) (*PhysicalInterface, error) {
+	interfaces, err := v.GetFmcPhysicalInterfaces(ctx, deviceID)
+	if err != nil {
+		return nil, fmt.Errorf("getting physical interface by name: %s", err.Error())
+	}
+
+	for _, physicalInterface := range interfaces {
+		if physicalInterface.Name == name || physicalInterface.Ifname == name {
+			return &physicalInterface, nil
+		}
+	}
+	return nil, fmt.Errorf("no physical interface found with name %s", name)
+}
@@ -0,0 +1,106 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type DNSServerGroupServer struct {
+	Nameserver string `json:"name-server"`
+}
+
+type DNSServerGroupObject struct {
+	ID            string                 `json:"id,omitempty"`
+	Name          string                 `json:"name"`
+	Type          string                 `json:"type"`
+	Defaultdomain string                 `json:"defaultdomain,omitempty"`
+	Retries       int                    `json:"retries"`
+	Timeout       int                    `json:"timeout"`
+	Dnsservers    []DNSServerGroupServer `json:"dnsservers"`
+}
+
+type DNSServerGroupObjectsResponse struct {
+	Items []DNSServerGroupObject `json:"items"`
+}
+
+func (v *Client) CreateFmcDNSServerGroupObject(ctx context.Context, object *DNSServerGroupObject) (*DNSServerGroupObject, error) {
+	url := fmt.Sprintf("%s/object/dnsservergroups", v.domainBaseURL)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating dns server group objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating dns server group objects: %s - %s", url, err.Error())
+	}
+	item := &DNSServerGroupObject{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating dns server group objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcDNSServerGroupObject(ctx context.Context, id string) (*DNSServerGroupObject, error) {
+	url := fmt.Sprintf("%s/object/dnsservergroups/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting dns server group objects: %s - %s", url, err.Error())
+	}
+	item := &DNSServerGroupObject{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting dns server group objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcDNSServerGroupObjectByName(ctx context.Context, name string) (*DNSServerGroupObject, error) {
+	url := v.buildURL("object/dnsservergroups", NewQuery().Expanded(true).Limit(fmc_query_limit).Filter("nameOrValue", name))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting dns server group object by name: %s - %s", url, err.Error())
+	}
+	resp := &DNSServerGroupObjectsResponse{}
+	err = v.DoRequest(req, resp, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting dns server group object by name: %s - %s", url, err.Error())
+	}
+	for _, item := range resp.Items {
+		if item.Name == name {
+			return v.GetFmcDNSServerGroupObject(ctx, item.ID)
+		}
+	}
+	return nil, fmt.Errorf("no dns server group object found with name %s", name)
+}
+
+func (v *Client) UpdateFmcDNSServerGroupObject(ctx context.Context, id string, object *DNSServerGroupObject) (*DNSServerGroupObject, error) {
+	url := fmt.Sprintf("%s/object/dnsservergroups/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating dns server group objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating dns server group objects: %s - %s", url, err.Error())
+	}
+	item := &DNSServerGroupObject{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating dns server group objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcDNSServerGroupObject(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/object/dnsservergroups/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting dns server group objects: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
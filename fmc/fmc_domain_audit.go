@@ -0,0 +1,91 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// API paths of the policies and objects which can be audited, keyed by the type returned by FMC
+var domain_audit_paths = map[string]string{
+	"AccessPolicy":       "policy/accesspolicies",
+	"PrefilterPolicy":    "policy/prefilterpolicies",
+	"FTDNatPolicy":       "policy/ftdnatpolicies",
+	"IntrusionPolicy":    "policy/intrusionpolicies",
+	"FilePolicy":         "policy/filepolicies",
+	"SecurityZone":       "object/securityzones",
+	"Network":            "object/networks",
+	"Host":               "object/hosts",
+	"Range":              "object/ranges",
+	"FQDN":               "object/fqdns",
+	"NetworkGroup":       "object/networkgroups",
+	"ProtocolPortObject": "object/protocolportobjects",
+	"PortObjectGroup":    "object/portobjectgroups",
+	"ICMPV4Object":       "object/icmpv4objects",
+	"Url":                "object/urls",
+	"UrlGroup":           "object/urlgroups",
+	"DynamicObject":      "object/dynamicobjects",
+}
+
+type AuditObject struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Metadata struct {
+		Lastuser struct {
+			Name string `json:"name"`
+		} `json:"lastUser"`
+		// Milliseconds since the epoch
+		Timestamp int64 `json:"timestamp"`
+		Readonly  struct {
+			State bool `json:"state"`
+		} `json:"readOnly"`
+	} `json:"metadata"`
+}
+
+// LastModified returns the time the object was last modified at
+func (o *AuditObject) LastModified() time.Time {
+	return time.Unix(0, o.Metadata.Timestamp*int64(time.Millisecond)).UTC()
+}
+
+type AuditObjectsResponse struct {
+	Items  []AuditObject `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcAuditObjects returns all the policies or objects of the given type in the domain with their metadata,
+// the system defined objects are skipped since they can only be changed by FMC updates
+func (v *Client) GetFmcAuditObjects(ctx context.Context, objectType string) ([]AuditObject, error) {
+	path, ok := domain_audit_paths[objectType]
+	if !ok {
+		return nil, fmt.Errorf("getting audit objects: unknown object type %s", objectType)
+	}
+	objects := []AuditObject{}
+	for offset := 0; ; {
+		url := v.buildURL(path, NewQuery().Expanded(true).Offset(offset).Limit(fmc_query_limit))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting audit objects: %s - %s", url, err.Error())
+		}
+		res := &AuditObjectsResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting audit objects: %s - %s", url, err.Error())
+		}
+		for _, object := range res.Items {
+			if !object.Metadata.Readonly.State {
+				objects = append(objects, object)
+			}
+		}
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return objects, nil
+		}
+	}
+}
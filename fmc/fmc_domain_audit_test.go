@@ -0,0 +1,41 @@
+package fmc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetFmcAuditObjects(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items": [
+			{"id": "1", "name": "web", "type": "Host", "metadata": {"lastUser": {"name": "terraform"}, "timestamp": 1760600000000}},
+			{"id": "2", "name": "db", "type": "Host", "metadata": {"lastUser": {"name": "alice"}, "timestamp": 1760700000000}},
+			{"id": "3", "name": "any-ipv4", "type": "Host", "metadata": {"readOnly": {"state": true}}}
+		], "paging": {"count": 3}}`))
+	})
+
+	objects, err := c.GetFmcAuditObjects(context.Background(), "Host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 2 {
+		t.Fatalf("expected the system defined object to be skipped, got %v", objects)
+	}
+	if expected := "2025-10-16T07:33:20Z"; objects[0].LastModified().Format(time.RFC3339) != expected {
+		t.Errorf("expected last modified %s, got %s", expected, objects[0].LastModified().Format(time.RFC3339))
+	}
+
+	ignoreUsers := map[string]bool{"terraform": true}
+	since := time.Date(2025, 10, 17, 0, 0, 0, 0, time.UTC)
+	for i, flagged := range []bool{false, true} {
+		if flaggedAuditObject(&objects[i], ignoreUsers, since) != flagged {
+			t.Errorf("expected %s flagged %t", objects[i].Name, flagged)
+		}
+	}
+	if flaggedAuditObject(&objects[1], ignoreUsers, since.Add(48*time.Hour)) {
+		t.Errorf("expected %s modified before since not to be flagged", objects[1].Name)
+	}
+}
@@ -36,7 +36,7 @@ type DynamicObjectsResponse struct {
 }
 
 func (v *Client) GetFmcDynamicObjectByName(ctx context.Context, name string) (*DynamicObjectResponse, error) {
-	url := fmt.Sprintf("%s/object/dynamicobjects?expanded=true&name=%s", v.domainBaseURL, name)
+	url := v.buildURL("object/dynamicobjects", NewQuery().Expanded(true).Set("name", name))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting dynamic object by name: %s - %s", url, err.Error())
@@ -0,0 +1,45 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type ExtendedAccessList struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type ExtendedAccessListsResponse struct {
+	Items []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"items"`
+}
+
+func (v *Client) GetFmcExtendedAccessListByName(ctx context.Context, name string) (*ExtendedAccessList, error) {
+	url := v.buildURL("object/extendedaccesslists", NewQuery().Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting extended access list by name: %s - %s", url, err.Error())
+	}
+	accessLists := &ExtendedAccessListsResponse{}
+	err = v.DoRequest(req, accessLists, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting extended access list by name: %s - %s", url, err.Error())
+	}
+
+	for _, accessList := range accessLists.Items {
+		if accessList.Name == name {
+			return &ExtendedAccessList{
+				ID:   accessList.ID,
+				Name: accessList.Name,
+				Type: accessList.Type,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no extended access list found with name %s", name)
+}
@@ -33,7 +33,7 @@ type FilePolicy struct {
 }
 
 func (v *Client) GetFmcFilePolicyByName(ctx context.Context, name string) (*FilePolicy, error) {
-	url := fmt.Sprintf("%s/policy/filepolicies", v.domainBaseURL)
+	url := v.buildURL("policy/filepolicies", NewQuery().Limit(fmc_query_limit))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting File policy by name: %s - %s", url, err.Error())
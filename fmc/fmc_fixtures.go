@@ -0,0 +1,273 @@
+package fmc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Fixtures are the requests sent to FMC and the responses to them, recorded from a live FMC so that the
+// acceptance tests can be replayed offline. Set FMC_FIXTURES_MODE to "record" or "replay" and
+// FMC_FIXTURES_DIR to the directory of the fixtures, e.g. testdata/fixtures/TestAccFmcNetworkObjectsBasic.
+//
+// Responses are replayed in the recorded order per method and path, the last one is repeated for polls.
+var (
+	fixtures_mode_env = "FMC_FIXTURES_MODE"
+	fixtures_dir_env  = "FMC_FIXTURES_DIR"
+)
+
+// Response headers needed to replay a login, the tokens are redacted
+var fixture_headers = []string{"Content-Type", "DOMAIN_UUID", "X-Auth-Access-Token", "X-Auth-Refresh-Token"}
+
+// Parts of header and JSON attribute names whose values are redacted in the fixtures
+var fixture_redacted_names = []string{"password", "secret", "token", "regkey", "natid", "presharedkey", "authorization"}
+
+var fixture_redacted_value = "REDACTED"
+
+// Host the FMC host is replaced with in the fixtures, e.g. in the links of the responses
+var fixture_host = "fmc.example.com"
+
+type fixture struct {
+	Method       string            `json:"method"`
+	Path         string            `json:"path"`
+	RequestBody  string            `json:"request_body,omitempty"`
+	Status       int               `json:"status"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	ResponseBody string            `json:"response_body,omitempty"`
+}
+
+func (f *fixture) key() string {
+	return f.Method + " " + f.Path
+}
+
+// fixturesTransport records or replays the requests, it is shared by all clients since terraform configures
+// the provider again for every step of a test
+type fixturesTransport struct {
+	mode     string
+	dir      string
+	base     http.RoundTripper
+	mutex    sync.Mutex
+	recorded int
+	replays  map[string][]*fixture
+}
+
+var (
+	fixturesTransportOnce      sync.Once
+	sharedFixturesTransport    *fixturesTransport
+	sharedFixturesTransportErr error
+)
+
+// withFixtures wraps the transport to record or replay fixtures if FMC_FIXTURES_MODE is set
+func withFixtures(base http.RoundTripper) http.RoundTripper {
+	mode := os.Getenv(fixtures_mode_env)
+	if mode == "" {
+		return base
+	}
+	fixturesTransportOnce.Do(func() {
+		sharedFixturesTransport, sharedFixturesTransportErr = newFixturesTransport(mode, os.Getenv(fixtures_dir_env), base)
+	})
+	if sharedFixturesTransportErr != nil {
+		log.Printf("Fixtures are disabled: %s", sharedFixturesTransportErr.Error())
+		return base
+	}
+	return sharedFixturesTransport
+}
+
+func newFixturesTransport(mode, dir string, base http.RoundTripper) (*fixturesTransport, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("%s must be set along with %s", fixtures_dir_env, fixtures_mode_env)
+	}
+	t := &fixturesTransport{mode: mode, dir: dir, base: base}
+	switch mode {
+	case "record":
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("creating fixtures directory %s: %s", dir, err.Error())
+		}
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) != 0 {
+			return nil, fmt.Errorf("fixtures directory %s is not empty, remove the old fixtures before recording", dir)
+		}
+	case "replay":
+		replays, err := loadFixtures(dir)
+		if err != nil {
+			return nil, err
+		}
+		t.replays = replays
+	default:
+		return nil, fmt.Errorf("%s must be \"record\" or \"replay\", got: %q", fixtures_mode_env, mode)
+	}
+	return t, nil
+}
+
+func loadFixtures(dir string) (map[string][]*fixture, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no fixtures found in %s", dir)
+	}
+	sort.Strings(files)
+	replays := map[string][]*fixture{}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading fixture %s: %s", file, err.Error())
+		}
+		f := &fixture{}
+		if err := json.Unmarshal(content, f); err != nil {
+			return nil, fmt.Errorf("reading fixture %s: %s", file, err.Error())
+		}
+		replays[f.key()] = append(replays[f.key()], f)
+	}
+	return replays, nil
+}
+
+func (t *fixturesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == "replay" {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+func (t *fixturesTransport) replay(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := req.Method + " " + req.URL.RequestURI()
+	replays := t.replays[key]
+	if len(replays) == 0 {
+		return nil, fmt.Errorf("no fixture recorded for %s", key)
+	}
+	f := replays[0]
+	if len(replays) > 1 {
+		t.replays[key] = replays[1:]
+	}
+
+	res := &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)),
+		StatusCode:    f.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(strings.NewReader(f.ResponseBody)),
+		ContentLength: int64(len(f.ResponseBody)),
+		Request:       req,
+	}
+	for name, value := range f.Headers {
+		res.Header.Set(name, value)
+	}
+	return res, nil
+}
+
+func (t *fixturesTransport) record(req *http.Request) (*http.Response, error) {
+	requestBody := []byte{}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		requestBody, err = ioutil.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	f := &fixture{
+		Method:       req.Method,
+		Path:         req.URL.RequestURI(),
+		RequestBody:  sanitizeFixtureBody(requestBody, req.URL.Host),
+		Status:       res.StatusCode,
+		Headers:      map[string]string{},
+		ResponseBody: sanitizeFixtureBody(responseBody, req.URL.Host),
+	}
+	for _, name := range fixture_headers {
+		if value := res.Header.Get(name); value != "" {
+			if isRedactedFixtureName(name) {
+				value = fixture_redacted_value
+			}
+			f.Headers[name] = value
+		}
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.recorded++
+	content, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	file := filepath.Join(t.dir, fmt.Sprintf("%04d.json", t.recorded))
+	if err := ioutil.WriteFile(file, content, 0644); err != nil {
+		return nil, fmt.Errorf("writing fixture %s: %s", file, err.Error())
+	}
+	return res, nil
+}
+
+func isRedactedFixtureName(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+	for _, redacted := range fixture_redacted_names {
+		if strings.Contains(name, redacted) {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeFixtureBody redacts the secrets in JSON bodies and replaces the FMC host
+func sanitizeFixtureBody(body []byte, host string) string {
+	if host != "" {
+		body = bytes.ReplaceAll(body, []byte(host), []byte(fixture_host))
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	sanitized, err := json.Marshal(sanitizeFixtureValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(sanitized)
+}
+
+func sanitizeFixtureValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if _, ok := item.(string); ok && isRedactedFixtureName(key) {
+				v[key] = fixture_redacted_value
+				continue
+			}
+			v[key] = sanitizeFixtureValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = sanitizeFixtureValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,77 @@
+package fmc
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFixturesRecordReplay(t *testing.T) {
+	recorder, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/generatetoken") {
+			w.Header().Set("X-Auth-Access-Token", "secret-token")
+			w.Header().Set("DOMAIN_UUID", "e276abec-e0f2-11e3-8169-6d9ed49b625f")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","name":"terraform","type":"Host","value":"10.0.0.1","regKey":"cisco123"}`))
+	})
+	host := strings.TrimPrefix(server.URL, "https://")
+	dir := t.TempDir()
+
+	recording, err := newFixturesTransport("record", dir, recorder.client.Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder.client.Transport = recording
+	if err := recorder.Login(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recorder.GetFmcHostObject(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil || len(files) != 2 {
+		t.Fatalf("expected 2 fixtures, got %v %v", files, err)
+	}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, leaked := range []string{"secret-token", "cisco123", host} {
+			if strings.Contains(string(content), leaked) {
+				t.Fatalf("fixture %s contains %s: %s", file, leaked, content)
+			}
+		}
+	}
+
+	// Replay against a host which does not exist
+	replayer := NewClient("admin", "password", host, true)
+	replaying, err := newFixturesTransport("replay", dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayer.client.Transport = replaying
+	server.Close()
+	if err := replayer.Login(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		item, err := replayer.GetFmcHostObject(context.Background(), "1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if item.Name != "terraform" || item.Value != "10.0.0.1" {
+			t.Fatalf("unexpected host object replayed: %+v", item)
+		}
+	}
+	if _, err := replayer.GetFmcHostObject(context.Background(), "2"); err == nil {
+		t.Fatal("request without fixture was replayed")
+	}
+}
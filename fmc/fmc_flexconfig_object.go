@@ -0,0 +1,89 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type FlexConfigObject struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Deploy      string `json:"deploy"`
+	Objecttype  string `json:"objectType"`
+	Content     string `json:"content"`
+}
+
+type FlexConfigObjectResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Deploy      string `json:"deploy"`
+	Objecttype  string `json:"objectType"`
+	Content     string `json:"content"`
+}
+
+func (v *Client) CreateFmcFlexConfigObject(ctx context.Context, object *FlexConfigObject) (*FlexConfigObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/flexconfigobjects", v.domainBaseURL)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating flexconfig objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating flexconfig objects: %s - %s", url, err.Error())
+	}
+	item := &FlexConfigObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating flexconfig objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcFlexConfigObject(ctx context.Context, id string) (*FlexConfigObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/flexconfigobjects/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting flexconfig objects: %s - %s", url, err.Error())
+	}
+	item := &FlexConfigObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting flexconfig objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcFlexConfigObject(ctx context.Context, id string, object *FlexConfigObject) (*FlexConfigObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/flexconfigobjects/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating flexconfig objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating flexconfig objects: %s - %s", url, err.Error())
+	}
+	item := &FlexConfigObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating flexconfig objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcFlexConfigObject(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/object/flexconfigobjects/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting flexconfig objects: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
@@ -0,0 +1,100 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type FlexConfigPolicySubConfig struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type FlexConfigPolicy struct {
+	ID                 string                      `json:"id,omitempty"`
+	Name               string                      `json:"name"`
+	Type               string                      `json:"type"`
+	Description        string                      `json:"description"`
+	Prependflexconfigs []FlexConfigPolicySubConfig `json:"prependFlexConfigs"`
+	Appendflexconfigs  []FlexConfigPolicySubConfig `json:"appendFlexConfigs"`
+}
+
+type FlexConfigPolicyResponse struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Type               string `json:"type"`
+	Description        string `json:"description"`
+	Prependflexconfigs []struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"prependFlexConfigs"`
+	Appendflexconfigs []struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"appendFlexConfigs"`
+}
+
+func (v *Client) CreateFmcFlexConfigPolicy(ctx context.Context, object *FlexConfigPolicy) (*FlexConfigPolicyResponse, error) {
+	url := fmt.Sprintf("%s/policy/flexconfigpolicies", v.domainBaseURL)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating flexconfig policies: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating flexconfig policies: %s - %s", url, err.Error())
+	}
+	item := &FlexConfigPolicyResponse{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating flexconfig policies: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcFlexConfigPolicy(ctx context.Context, id string) (*FlexConfigPolicyResponse, error) {
+	url := fmt.Sprintf("%s/policy/flexconfigpolicies/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting flexconfig policies: %s - %s", url, err.Error())
+	}
+	item := &FlexConfigPolicyResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting flexconfig policies: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcFlexConfigPolicy(ctx context.Context, id string, object *FlexConfigPolicy) (*FlexConfigPolicyResponse, error) {
+	url := fmt.Sprintf("%s/policy/flexconfigpolicies/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating flexconfig policies: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating flexconfig policies: %s - %s", url, err.Error())
+	}
+	item := &FlexConfigPolicyResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating flexconfig policies: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcFlexConfigPolicy(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/policy/flexconfigpolicies/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting flexconfig policies: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
@@ -25,10 +25,22 @@ type DeployableDeviceResponse struct {
 		ID   string `json:"id"`
 		Type string `json:"type"`
 	} `json:"device"`
+	Canbedeployed       bool   `json:"canBeDeployed"`
+	Uptodate            bool   `json:"upToDate"`
+	Trafficinterruption string `json:"trafficInterruption"`
 }
 
-func (v *Client) GetFmcDeployableDevice(ctx context.Context, device_id string) (*DeployableDeviceResponse, error) {
-	url := fmt.Sprintf("%s/deployment/deployabledevices?expanded=true", v.domainBaseURL)
+type DeployPendingChange struct {
+	Policy struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"policy"`
+}
+
+// GetFmcDeployableDevices returns the devices with changes to deploy
+func (v *Client) GetFmcDeployableDevices(ctx context.Context) ([]DeployableDeviceResponse, error) {
+	url := v.buildURL("deployment/deployabledevices", NewQuery().Expanded(true))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting deployable devices: %s - %s", url, err.Error())
@@ -40,7 +52,15 @@ func (v *Client) GetFmcDeployableDevice(ctx context.Context, device_id string) (
 	if err != nil {
 		return nil, fmt.Errorf("getting deployable devices: %s - %s", url, err.Error())
 	}
-	for _, item := range res.Items {
+	return res.Items, nil
+}
+
+func (v *Client) GetFmcDeployableDevice(ctx context.Context, device_id string) (*DeployableDeviceResponse, error) {
+	items, err := v.GetFmcDeployableDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
 		if item.Device.ID == device_id {
 			return &item, nil
 		}
@@ -48,6 +68,23 @@ func (v *Client) GetFmcDeployableDevice(ctx context.Context, device_id string) (
 	return nil, fmt.Errorf("no devices found for deployment with ID %s", device_id)
 }
 
+// GetFmcDeployPendingChanges returns the policies changed since the last deployment to the device
+func (v *Client) GetFmcDeployPendingChanges(ctx context.Context, device_id string) ([]DeployPendingChange, error) {
+	url := v.buildURL(fmt.Sprintf("deployment/deployabledevices/%s/pendingchanges", device_id), NewQuery().Expanded(true).Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting pending changes: %s - %s", url, err.Error())
+	}
+	res := &struct {
+		Items []DeployPendingChange `json:"items"`
+	}{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting pending changes: %s - %s", url, err.Error())
+	}
+	return res.Items, nil
+}
+
 func (v *Client) DeployToFTD(ctx context.Context, object FtdDeploy) error {
 	url := fmt.Sprintf("%s/deployment/deploymentrequests", v.domainBaseURL)
 	body, err := json.Marshal(&object)
@@ -0,0 +1,263 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type FTDPlatformSettingsPolicy struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type FTDPlatformSettingsPoliciesResponse struct {
+	Items []FTDPlatformSettingsPolicy `json:"items"`
+}
+
+type FTDPlatformSettingsSubConfig struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type FTDSNMPUser struct {
+	Username                    string `json:"username"`
+	Securitylevel               string `json:"securityLevel"`
+	Authenticationalgorithmtype string `json:"authenticationAlgorithmType,omitempty"`
+	Authenticationpassword      string `json:"authenticationPassword,omitempty"`
+	Encryptiontype              string `json:"encryptionType,omitempty"`
+	Encryptionpassword          string `json:"encryptionPassword,omitempty"`
+}
+
+type FTDSNMPHost struct {
+	Ipaddress   FTDPlatformSettingsSubConfig   `json:"ipAddress"`
+	Interfaces  []FTDPlatformSettingsSubConfig `json:"interfaces,omitempty"`
+	Snmpversion string                         `json:"snmpVersion"`
+	Username    string                         `json:"userName"`
+	Poll        bool                           `json:"poll"`
+	Trap        bool                           `json:"trap"`
+	Trapport    int                            `json:"trapPort,omitempty"`
+}
+
+type FTDSNMPTraps struct {
+	Authentication bool `json:"authentication"`
+	Linkup         bool `json:"linkUp"`
+	Linkdown       bool `json:"linkDown"`
+	Coldstart      bool `json:"coldStart"`
+	Warmstart      bool `json:"warmStart"`
+}
+
+type FTDSNMPSettings struct {
+	ID                      string        `json:"id,omitempty"`
+	Type                    string        `json:"type"`
+	Enablesnmpservers       bool          `json:"enableSNMPServers"`
+	Location                string        `json:"location"`
+	Systemadministratorname string        `json:"systemAdministratorName"`
+	Port                    int           `json:"port"`
+	Snmpusers               []FTDSNMPUser `json:"snmpUsers"`
+	Snmpmgmthosts           []FTDSNMPHost `json:"snmpMgmtHosts"`
+	Snmptraps               FTDSNMPTraps  `json:"snmpTraps"`
+}
+
+type FTDSNMPSettingsResponse struct {
+	Items []FTDSNMPSettings `json:"items"`
+}
+
+type FTDTimezoneSettings struct {
+	ID             string                        `json:"id,omitempty"`
+	Type           string                        `json:"type"`
+	Timezoneobject *FTDPlatformSettingsSubConfig `json:"timeZoneObject"`
+}
+
+type FTDTimezoneSettingsResponse struct {
+	Items []FTDTimezoneSettings `json:"items"`
+}
+
+type FTDDNSSettings struct {
+	ID                  string                         `json:"id,omitempty"`
+	Type                string                         `json:"type"`
+	Enablednsresolution bool                           `json:"enableDnsResolution"`
+	Dnsservergroup      *FTDPlatformSettingsSubConfig  `json:"dnsServerGroup,omitempty"`
+	Interfaceobjects    []FTDPlatformSettingsSubConfig `json:"interfaceObjects,omitempty"`
+	Expiryentrytimer    int                            `json:"expiryEntryTimer,omitempty"`
+	Polltimer           int                            `json:"pollTimer,omitempty"`
+}
+
+type FTDDNSSettingsResponse struct {
+	Items []FTDDNSSettings `json:"items"`
+}
+
+func (v *Client) GetFmcFTDPlatformSettingsPolicyByName(ctx context.Context, name string) (*FTDPlatformSettingsPolicy, error) {
+	url := v.buildURL("policy/ftdplatformsettingspolicies", NewQuery().Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD platform settings policy by name: %s - %s", url, err.Error())
+	}
+	policies := &FTDPlatformSettingsPoliciesResponse{}
+	err = v.DoRequest(req, policies, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD platform settings policy by name: %s - %s", url, err.Error())
+	}
+
+	for _, policy := range policies.Items {
+		if policy.Name == name {
+			return &policy, nil
+		}
+	}
+	return nil, fmt.Errorf("no FTD platform settings policy found with name %s", name)
+}
+
+// The SNMP settings are a singleton within the platform settings policy, they are created along with the policy
+func (v *Client) GetFmcFTDSNMPSettings(ctx context.Context, policyID string) (*FTDSNMPSettings, error) {
+	url := v.buildURL(fmt.Sprintf("policy/ftdplatformsettingspolicies/%s/snmpsettings", policyID), NewQuery().Expanded(true))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD SNMP settings: %s - %s", url, err.Error())
+	}
+	res := &FTDSNMPSettingsResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD SNMP settings: %s - %s", url, err.Error())
+	}
+	if len(res.Items) == 0 {
+		return nil, fmt.Errorf("getting FTD SNMP settings: %s - no SNMP settings found in the platform settings policy", url)
+	}
+	return &res.Items[0], nil
+}
+
+func (v *Client) UpdateFmcFTDSNMPSettings(ctx context.Context, policyID, id string, settings *FTDSNMPSettings) (*FTDSNMPSettings, error) {
+	url := fmt.Sprintf("%s/policy/ftdplatformsettingspolicies/%s/snmpsettings/%s", v.domainBaseURL, policyID, id)
+	body, err := json.Marshal(&settings)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD SNMP settings: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD SNMP settings: %s - %s", url, err.Error())
+	}
+	item := &FTDSNMPSettings{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD SNMP settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+// The timezone settings are a singleton within the platform settings policy, they are created along with the policy
+func (v *Client) GetFmcFTDTimezoneSettings(ctx context.Context, policyID string) (*FTDTimezoneSettings, error) {
+	url := v.buildURL(fmt.Sprintf("policy/ftdplatformsettingspolicies/%s/timezonesettings", policyID), NewQuery().Expanded(true))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD timezone settings: %s - %s", url, err.Error())
+	}
+	res := &FTDTimezoneSettingsResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD timezone settings: %s - %s", url, err.Error())
+	}
+	if len(res.Items) == 0 {
+		return nil, fmt.Errorf("getting FTD timezone settings: %s - no timezone settings found in the platform settings policy", url)
+	}
+	return &res.Items[0], nil
+}
+
+// GetFmcDevicesWithoutTimezone returns the names of the devices the policy is assigned to whose FTD platform
+// settings policy has no timezone object, time ranges are evaluated in UTC on them
+func (v *Client) GetFmcDevicesWithoutTimezone(ctx context.Context, policyID string) ([]string, error) {
+	assignments, err := v.GetFmcPolicyDevicesAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	platformSettings := map[string]string{}
+	var targets []PolicyDevicesAssignmentSubConfig
+	for _, assignment := range assignments {
+		if assignment.Policy.ID == policyID {
+			targets = assignment.Targets
+		}
+		if assignment.Policy.Type == ftd_platform_settings_policy_type {
+			for _, target := range assignment.Targets {
+				platformSettings[target.ID] = assignment.Policy.ID
+			}
+		}
+	}
+
+	devices := []string{}
+	timezones := map[string]bool{}
+	for _, target := range targets {
+		settingsID, ok := platformSettings[target.ID]
+		if ok {
+			if _, read := timezones[settingsID]; !read {
+				settings, err := v.GetFmcFTDTimezoneSettings(ctx, settingsID)
+				if err != nil {
+					return nil, err
+				}
+				timezones[settingsID] = settings.Timezoneobject != nil && settings.Timezoneobject.ID != ""
+			}
+		}
+		if !ok || !timezones[settingsID] {
+			name := target.Name
+			if name == "" {
+				name = target.ID
+			}
+			devices = append(devices, name)
+		}
+	}
+	return devices, nil
+}
+
+func (v *Client) UpdateFmcFTDTimezoneSettings(ctx context.Context, policyID, id string, settings *FTDTimezoneSettings) (*FTDTimezoneSettings, error) {
+	url := fmt.Sprintf("%s/policy/ftdplatformsettingspolicies/%s/timezonesettings/%s", v.domainBaseURL, policyID, id)
+	body, err := json.Marshal(&settings)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD timezone settings: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD timezone settings: %s - %s", url, err.Error())
+	}
+	item := &FTDTimezoneSettings{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD timezone settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+// The DNS settings are a singleton within the platform settings policy, they are created along with the policy
+func (v *Client) GetFmcFTDDNSSettings(ctx context.Context, policyID string) (*FTDDNSSettings, error) {
+	url := v.buildURL(fmt.Sprintf("policy/ftdplatformsettingspolicies/%s/dnssettings", policyID), NewQuery().Expanded(true))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD DNS settings: %s - %s", url, err.Error())
+	}
+	res := &FTDDNSSettingsResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD DNS settings: %s - %s", url, err.Error())
+	}
+	if len(res.Items) == 0 {
+		return nil, fmt.Errorf("getting FTD DNS settings: %s - no DNS settings found in the platform settings policy", url)
+	}
+	return &res.Items[0], nil
+}
+
+func (v *Client) UpdateFmcFTDDNSSettings(ctx context.Context, policyID, id string, settings *FTDDNSSettings) (*FTDDNSSettings, error) {
+	url := fmt.Sprintf("%s/policy/ftdplatformsettingspolicies/%s/dnssettings/%s", v.domainBaseURL, policyID, id)
+	body, err := json.Marshal(&settings)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD DNS settings: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD DNS settings: %s - %s", url, err.Error())
+	}
+	item := &FTDDNSSettings{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD DNS settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
@@ -0,0 +1,97 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type FTDS2SVPN struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Topologytype string `json:"topologyType"`
+	Ikev1enabled bool   `json:"ikeV1Enabled"`
+	Ikev2enabled bool   `json:"ikeV2Enabled"`
+}
+
+type FTDS2SVPNsResponse struct {
+	Items []FTDS2SVPN `json:"items"`
+}
+
+type FTDS2SVPNIKEVersionSettings struct {
+	Authenticationtype          string `json:"authenticationType"`
+	Manualpresharedkey          string `json:"manualPreSharedKey,omitempty"`
+	Automaticpresharedkeylength int    `json:"automaticPreSharedKeyLength,omitempty"`
+	// The IKE policies are not managed here, but have to be sent back unchanged
+	Policies json.RawMessage `json:"policies,omitempty"`
+}
+
+type FTDS2SVPNIKESettings struct {
+	ID            string                       `json:"id,omitempty"`
+	Type          string                       `json:"type"`
+	Ikev1settings *FTDS2SVPNIKEVersionSettings `json:"ikeV1Settings,omitempty"`
+	Ikev2settings *FTDS2SVPNIKEVersionSettings `json:"ikeV2Settings,omitempty"`
+}
+
+type FTDS2SVPNIKESettingsResponse struct {
+	Items []FTDS2SVPNIKESettings `json:"items"`
+}
+
+func (v *Client) GetFmcFTDS2SVPNByName(ctx context.Context, name string) (*FTDS2SVPN, error) {
+	url := v.buildURL("policy/ftds2svpns", NewQuery().Expanded(true).Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD S2S VPN by name: %s - %s", url, err.Error())
+	}
+	vpns := &FTDS2SVPNsResponse{}
+	err = v.DoRequest(req, vpns, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD S2S VPN by name: %s - %s", url, err.Error())
+	}
+
+	for _, vpn := range vpns.Items {
+		if vpn.Name == name {
+			return &vpn, nil
+		}
+	}
+	return nil, fmt.Errorf("no FTD S2S VPN found with name %s", name)
+}
+
+// The IKE settings are a singleton within the VPN topology, they are created along with the topology
+func (v *Client) GetFmcFTDS2SVPNIKESettings(ctx context.Context, vpnID string) (*FTDS2SVPNIKESettings, error) {
+	url := v.buildURL(fmt.Sprintf("policy/ftds2svpns/%s/ikesettings", vpnID), NewQuery().Expanded(true))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD S2S VPN IKE settings: %s - %s", url, err.Error())
+	}
+	res := &FTDS2SVPNIKESettingsResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting FTD S2S VPN IKE settings: %s - %s", url, err.Error())
+	}
+	if len(res.Items) == 0 {
+		return nil, fmt.Errorf("getting FTD S2S VPN IKE settings: %s - no IKE settings found in the VPN topology", url)
+	}
+	return &res.Items[0], nil
+}
+
+func (v *Client) UpdateFmcFTDS2SVPNIKESettings(ctx context.Context, vpnID, id string, settings *FTDS2SVPNIKESettings) (*FTDS2SVPNIKESettings, error) {
+	url := fmt.Sprintf("%s/policy/ftds2svpns/%s/ikesettings/%s", v.domainBaseURL, vpnID, id)
+	body, err := json.Marshal(&settings)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD S2S VPN IKE settings: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD S2S VPN IKE settings: %s - %s", url, err.Error())
+	}
+	item := &FTDS2SVPNIKESettings{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating FTD S2S VPN IKE settings: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
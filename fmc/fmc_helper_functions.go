@@ -1,12 +1,49 @@
 package fmc
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"reflect"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Maximum name lengths accepted by FMC
+const (
+	fmc_object_name_max_length      = 64
+	fmc_policy_name_max_length      = 64
+	fmc_access_rule_name_max_length = 30
+)
+
+// Characters which FMC rejects in names
+var fmc_name_invalid_characters = `<>&"'\`
+
+// Prefix of the hashes stored in the state instead of write-only secrets
+var secret_hash_prefix = "sha256:"
+
+// The description markers of all the configured providers, see the fmc_description_marker provider option.
+// DiffSuppressFuncs have no access to the provider meta, so they split the descriptions read from FMC at any of
+// them, everything else uses the marker of the client.
+var description_markers = map[string]bool{}
+var description_markers_mutex = &sync.RWMutex{}
+
+// registerDescriptionMarker adds the description marker of a provider to the ones known to suppressDescriptionMarker
+func registerDescriptionMarker(marker string) {
+	if marker == "" {
+		return
+	}
+	description_markers_mutex.Lock()
+	defer description_markers_mutex.Unlock()
+	description_markers[marker] = true
+}
+
 func returnWithDiag(diags diag.Diagnostics, err error) diag.Diagnostics {
 	diags = append(diags, diag.Diagnostic{
 		Severity: diag.Error,
@@ -63,3 +100,319 @@ func ToMap(in interface{}, tag string) (map[string]interface{}, error) {
 	}
 	return out, nil
 }
+
+// validateFmcName returns a ValidateFunc enforcing the FMC naming rules, so that invalid names fail
+// during plan instead of with a 422 from FMC during apply.
+func validateFmcName(maxLength int) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		if v == "" {
+			errs = append(errs, fmt.Errorf("%q must not be empty", key))
+			return
+		}
+		if l := len([]rune(v)); l > maxLength {
+			errs = append(errs, fmt.Errorf("%q must be at most %d characters long, got: %d", key, maxLength, l))
+		}
+		if strings.TrimSpace(v) != v {
+			errs = append(errs, fmt.Errorf("%q must not start or end with whitespace, got: %q", key, v))
+		}
+		if strings.ContainsAny(v, fmc_name_invalid_characters) {
+			errs = append(errs, fmt.Errorf("%q must not contain any of %s, got: %q", key, fmc_name_invalid_characters, v))
+		}
+		return
+	}
+}
+
+// Kinds of the IP values of objects, both IPv4 and IPv6 values are accepted
+const (
+	ip_value_host    = "host"
+	ip_value_network = "network"
+	ip_value_range   = "range"
+)
+
+// parseIPValue parses an address, an address with a prefix length or a range of two addresses of the same version,
+// returning the addresses and the prefix length, -1 if none
+func parseIPValue(value string) ([]net.IP, int, error) {
+	prefix := -1
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) == 1 {
+		if address, network, err := net.ParseCIDR(parts[0]); err == nil {
+			prefix, _ = network.Mask.Size()
+			return []net.IP{address}, prefix, nil
+		}
+	}
+	if len(parts) > 2 {
+		return nil, prefix, fmt.Errorf("%q is not an IP range", value)
+	}
+	var addresses []net.IP
+	for _, part := range parts {
+		address := net.ParseIP(strings.TrimSpace(part))
+		if address == nil {
+			return nil, prefix, fmt.Errorf("%q is not an IP address", part)
+		}
+		addresses = append(addresses, address)
+	}
+	if len(addresses) == 2 && (addresses[0].To4() == nil) != (addresses[1].To4() == nil) {
+		return nil, prefix, fmt.Errorf("%q mixes IPv4 and IPv6 addresses", value)
+	}
+	return addresses, prefix, nil
+}
+
+// validateIPValue returns a ValidateFunc checking the IPv4 or IPv6 value of a host (an address), a network
+// (an address with or without a prefix length) or a range (two addresses separated by "-")
+func validateIPValue(kind string) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		addresses, prefix, err := parseIPValue(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%q must be an IPv4 or IPv6 %s, got: %s", key, kind, err.Error()))
+			return
+		}
+		if (kind == ip_value_range) != (len(addresses) == 2) || (kind == ip_value_host && prefix != -1) {
+			errs = append(errs, fmt.Errorf("%q must be an IPv4 or IPv6 %s, got: %q", key, kind, v))
+		} else if len(addresses) == 2 && bytes.Compare(addresses[0].To16(), addresses[1].To16()) > 0 {
+			errs = append(errs, fmt.Errorf("%q must be a range with the start address before the end address, got: %q", key, v))
+		}
+		return
+	}
+}
+
+// orderLikeState orders the items read from FMC like the matching items in the state, so that members returned in
+// another order are not shown as drift. The items not in the state follow in the order returned by FMC.
+func orderLikeState(state, read []interface{}, match func(state, read map[string]interface{}) bool) []interface{} {
+	ordered := make([]interface{}, 0, len(read))
+	used := make([]bool, len(read))
+	for _, s := range state {
+		for i, r := range read {
+			if !used[i] && match(s.(map[string]interface{}), r.(map[string]interface{})) {
+				ordered = append(ordered, r)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, r := range read {
+		if !used[i] {
+			ordered = append(ordered, r)
+		}
+	}
+	return ordered
+}
+
+// equivalentIPValues checks if two IP values are the same, FMC may return IPv6 values in another notation than
+// configured, e.g. "2001:db8::1" for "2001:DB8:0:0:0:0:0:1"
+func equivalentIPValues(a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	addressesA, prefixA, errA := parseIPValue(a)
+	addressesB, prefixB, errB := parseIPValue(b)
+	if errA != nil || errB != nil || prefixA != prefixB || len(addressesA) != len(addressesB) {
+		return false
+	}
+	for i := range addressesA {
+		if !addressesA[i].Equal(addressesB[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func suppressEquivalentIPValues(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentIPValues(old, new)
+}
+
+// attributeDeprecation describes a deprecated attribute of a resource and what to use instead
+type attributeDeprecation struct {
+	Attribute   string
+	Replacement string
+	Guidance    string
+}
+
+func (a attributeDeprecation) message() string {
+	message := fmt.Sprintf("%s is deprecated", a.Attribute)
+	if a.Replacement != "" {
+		message = fmt.Sprintf("%s, use %s instead", message, a.Replacement)
+	}
+	if a.Guidance != "" {
+		message = fmt.Sprintf("%s. %s", message, a.Guidance)
+	}
+	return message
+}
+
+// deprecate marks the attributes as deprecated in the schema of the resource, which makes terraform warn
+// about them during plan, and wraps create and update to repeat the guidance as warnings during apply.
+func deprecate(resource *schema.Resource, deprecations ...attributeDeprecation) *schema.Resource {
+	for _, deprecation := range deprecations {
+		attribute, ok := resource.Schema[deprecation.Attribute]
+		if !ok {
+			panic(fmt.Errorf("cannot deprecate unknown attribute %s", deprecation.Attribute))
+		}
+		attribute.Deprecated = deprecation.message()
+	}
+
+	withWarnings := func(fn schema.CreateContextFunc) schema.CreateContextFunc {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return append(fn(ctx, d, m), deprecationWarnings(d, deprecations)...)
+		}
+	}
+	resource.CreateContext = withWarnings(resource.CreateContext)
+	resource.UpdateContext = schema.UpdateContextFunc(withWarnings(schema.CreateContextFunc(resource.UpdateContext)))
+	return resource
+}
+
+// deprecationWarnings returns a warning for each of the deprecated attributes set in the configuration
+func deprecationWarnings(d *schema.ResourceData, deprecations []attributeDeprecation) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, deprecation := range deprecations {
+		if _, ok := d.GetOk(deprecation.Attribute); !ok {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("deprecated attribute %s", deprecation.Attribute),
+			Detail:   deprecation.message(),
+		})
+	}
+	return diags
+}
+
+// writeOnlySecret marks the secret as sensitive and only stores a hash of it in the state. FMC never
+// returns secrets, so the hash is enough to detect changes to the configured value.
+//
+// d.Get returns the configured value only while the secret itself changes, so use it for secrets which
+// are sent to FMC once, e.g. ForceNew attributes. Secrets which are sent with every update have to stay
+// in the state and are only marked sensitive.
+func writeOnlySecret(secret *schema.Schema) *schema.Schema {
+	secret.Sensitive = true
+	secret.StateFunc = func(val interface{}) string {
+		return hashSecret(val.(string))
+	}
+	// States written before the secret was write-only still hold the secret itself
+	secret.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		return hashSecret(old) == new
+	}
+	return secret
+}
+
+// hashSecret returns the hash of the secret which is stored in the state, hashes are returned unchanged
+func hashSecret(secret string) string {
+	if secret == "" || strings.HasPrefix(secret, secret_hash_prefix) {
+		return secret
+	}
+	sum := sha256.Sum256([]byte(secret))
+	return secret_hash_prefix + hex.EncodeToString(sum[:])
+}
+
+// Error messages FMC returns instead of a 404 for some objects which do not exist
+var fmc_not_found_messages = []string{"not found", "does not exist", "no longer exists", "invalid object id"}
+
+// isNotFound checks if the request failed because the object does not exist (anymore). Only the status code and
+// the error messages of FMC are checked, not the body, which may mention other objects which do not exist.
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := err.Error()
+	if strings.Contains(message, "wrong status code: 404") {
+		// Endpoints missing in older FMC versions return a 404 too
+		return !strings.Contains(message, fmc_missing_endpoint_message)
+	}
+	messages := strings.ToLower(fmcErrorMessages(message))
+	for _, notFound := range fmc_not_found_messages {
+		if strings.Contains(messages, notFound) {
+			return true
+		}
+	}
+	return false
+}
+
+// fmcErrorMessages returns the descriptions of the error messages of FMC in the error returned by doRequest
+func fmcErrorMessages(message string) string {
+	start := strings.Index(message, "error messages: ")
+	if start < 0 {
+		return ""
+	}
+	message = message[start+len("error messages: "):]
+	if end := strings.Index(message, ", body: "); end >= 0 {
+		message = message[:end]
+	}
+	return message
+}
+
+// alreadyDeleted returns the warning for deleting an object which was already deleted outside of terraform,
+// e.g. in the FMC UI. Deleting it is treated as success, so that the destroy does not fail.
+func alreadyDeleted(name string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s was already deleted", name),
+		Detail:   err.Error(),
+	}
+}
+
+// withDescriptionMarker returns the description sent to FMC, i.e. the configured description with the
+// description marker appended. Notes added after the marker in FMC, e.g. in the FMC UI, are kept on updates.
+func (v *Client) withDescriptionMarker(d *schema.ResourceData) string {
+	old, new := d.GetChange("description")
+	description, _ := splitDescription(new.(string), v.descriptionMarker)
+	if v.descriptionMarker == "" {
+		return description
+	}
+	if strings.TrimSpace(description) != "" {
+		description += " " + v.descriptionMarker
+	} else {
+		description = v.descriptionMarker
+	}
+	if _, notes := splitDescription(old.(string), v.descriptionMarker); notes != "" {
+		description += " " + notes
+	}
+	return description
+}
+
+// defaultComment returns the default comment for the operation on the named rule, with the {name} and
+// {operation} placeholders of the template replaced
+func (v *Client) defaultComment(name, operation string) string {
+	return strings.NewReplacer("{name}", name, "{operation}", operation).Replace(v.defaultCommentTemplate)
+}
+
+// mappedName returns the name of the named object of the kind in this FMC. A mapping of "<kind>:<name>"
+// takes precedence over a mapping of the name for all kinds, names without a mapping are kept.
+func (v *Client) mappedName(kind, name string) string {
+	if mapped, ok := v.nameMapping[kind+":"+name]; ok {
+		return mapped
+	}
+	if mapped, ok := v.nameMapping[name]; ok {
+		return mapped
+	}
+	return name
+}
+
+// splitDescription splits the description read from FMC into the configured description and the notes
+// added after the description marker
+func splitDescription(description, marker string) (string, string) {
+	i := strings.Index(description, marker)
+	if marker == "" || i < 0 {
+		return description, ""
+	}
+	return strings.TrimSuffix(description[:i], " "), strings.TrimSpace(description[i+len(marker):])
+}
+
+// suppressDescriptionMarker suppresses the difference between the configured description and the one read
+// from FMC, which has the description marker and the notes added after it appended
+func suppressDescriptionMarker(k, old, new string, d *schema.ResourceData) bool {
+	// Fix for bug in the FMC API which returns " " for empty description
+	if (new == " " && old == "") || (old == " " && new == "") || old == new {
+		return true
+	}
+	description_markers_mutex.RLock()
+	defer description_markers_mutex.RUnlock()
+	for marker := range description_markers {
+		if description, _ := splitDescription(old, marker); description == new || (new == " " && description == "") {
+			return true
+		}
+	}
+	return false
+}
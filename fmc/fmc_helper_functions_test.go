@@ -0,0 +1,239 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDeprecate(t *testing.T) {
+	resource := deprecate(&schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"old": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"new": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}, attributeDeprecation{
+		Attribute:   "old",
+		Replacement: "new",
+		Guidance:    "It will be removed in the next major release.",
+	})
+
+	expected := "old is deprecated, use new instead. It will be removed in the next major release."
+	if resource.Schema["old"].Deprecated != expected {
+		t.Fatalf("expected deprecation message %q, got: %q", expected, resource.Schema["old"].Deprecated)
+	}
+	if resource.Schema["new"].Deprecated != "" {
+		t.Fatalf("expected new not to be deprecated, got: %q", resource.Schema["new"].Deprecated)
+	}
+	if resource.UpdateContext != nil {
+		t.Fatalf("expected no update to be added")
+	}
+
+	for config, warnings := range map[string]int{"old": 1, "new": 0} {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{config: "value"})
+		diags := resource.CreateContext(context.Background(), d, nil)
+		if len(diags) != warnings {
+			t.Fatalf("expected %d warnings with %s set, got: %v", warnings, config, diags)
+		}
+		for _, d := range diags {
+			if d.Severity != diag.Warning || d.Detail != expected {
+				t.Fatalf("expected deprecation warning, got: %v", d)
+			}
+		}
+	}
+}
+
+func TestWriteOnlySecret(t *testing.T) {
+	secret := writeOnlySecret(&schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	})
+	if !secret.Sensitive {
+		t.Fatal("secret is not sensitive")
+	}
+	hash := secret.StateFunc("key")
+	if hash == "key" || !strings.HasPrefix(hash, secret_hash_prefix) {
+		t.Fatalf("secret is stored in the state: %s", hash)
+	}
+	if secret.StateFunc(hash) != hash {
+		t.Fatal("hash of the secret is hashed again")
+	}
+	if secret.StateFunc("") != "" {
+		t.Fatal("empty secret is hashed")
+	}
+	if !secret.DiffSuppressFunc("reg_key", "key", hash, nil) || !secret.DiffSuppressFunc("reg_key", hash, hash, nil) {
+		t.Fatal("unchanged secret has a diff")
+	}
+	if secret.DiffSuppressFunc("reg_key", hash, secret.StateFunc("other"), nil) {
+		t.Fatal("changed secret has no diff")
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	for err, notFound := range map[error]bool{
+		nil: false,
+		fmt.Errorf("deleting network object: https://fmc/object/networks/1 - wrong status code: 404, DELETE https://fmc/object/networks/1, body: {}"): true,
+		fmt.Errorf("deleting host object: https://fmc/object/hosts/1 - wrong status code: 400, error messages: [{The object does not exist}]"):        true,
+		fmt.Errorf("deleting security zone: https://fmc/object/securityzones/1 - wrong status code: 400, error messages: [{Object is in use}]"):       false,
+		// The body and the missing endpoints are not an object which does not exist
+		fmt.Errorf("deleting network group: https://fmc/object/networkgroups/1 - wrong status code: 400, error messages: [{Object is in use}], body: {\"description\": \"Referenced object 2 does not exist\"}"):                               false,
+		fmt.Errorf("deleting vni interface: https://fmc/devices/1/vniinterfaces/2 - wrong status code: 404, DELETE https://fmc/devices/1/vniinterfaces/2, the endpoint does not exist in this FMC, it may be unsupported on this FMC version"): false,
+		fmt.Errorf("updating access rule: https://fmc/policy/accesspolicies/1/accessrules/2 - wrong status code: 422, could not read error body as error json, body: Not Found"):                                                               false,
+	} {
+		if isNotFound(err) != notFound {
+			t.Errorf("isNotFound(%v) = %t, want %t", err, !notFound, notFound)
+		}
+	}
+}
+
+func TestDescriptionMarker(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDescriptionMarker,
+			},
+		},
+	}
+	c := &Client{descriptionMarker: "managed-by-terraform workspace=prod"}
+	registerDescriptionMarker(c.descriptionMarker)
+	defer delete(description_markers, c.descriptionMarker)
+	for _, test := range []struct {
+		state, config, stamped string
+	}{
+		{"", "", "managed-by-terraform workspace=prod"},
+		{"", " ", "managed-by-terraform workspace=prod"},
+		{"", "Web servers", "Web servers managed-by-terraform workspace=prod"},
+		{"Web servers managed-by-terraform workspace=prod", "Web servers", "Web servers managed-by-terraform workspace=prod"},
+		{"Web servers managed-by-terraform workspace=prod ticket 42", "DMZ web servers", "DMZ web servers managed-by-terraform workspace=prod ticket 42"},
+	} {
+		d := resource.TestResourceData()
+		d.SetId("1")
+		if err := d.Set("description", test.state); err != nil {
+			t.Fatal(err)
+		}
+		state := d.State()
+		d, err := schema.InternalMap(resource.Schema).Data(state, &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"description": {Old: test.state, New: test.config},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.withDescriptionMarker(d); got != test.stamped {
+			t.Errorf("withDescriptionMarker(%q, %q) = %q, want %q", test.state, test.config, got, test.stamped)
+		}
+		if !suppressDescriptionMarker("description", test.stamped, test.config, d) {
+			t.Errorf("difference between %q and %q is not suppressed", test.stamped, test.config)
+		}
+	}
+	if suppressDescriptionMarker("description", "Web servers managed-by-terraform workspace=prod ticket 42", "DMZ web servers", nil) {
+		t.Error("changed description is suppressed")
+	}
+	// Another provider, e.g. an alias for another FMC, keeps its own marker
+	other := &Client{}
+	if got := other.withDescriptionMarker(resource.TestResourceData()); got != "" {
+		t.Errorf("description changed without marker: %q", got)
+	}
+}
+
+func TestValidateIPValue(t *testing.T) {
+	for _, tc := range []struct {
+		kind  string
+		value string
+		valid bool
+	}{
+		{ip_value_host, "10.0.0.1", true},
+		{ip_value_host, "2001:db8::1", true},
+		{ip_value_host, "10.0.0.0/8", false},
+		{ip_value_host, "example.com", false},
+		{ip_value_network, "10.0.0.0/8", true},
+		{ip_value_network, "2001:db8::/32", true},
+		{ip_value_network, "2001:db8::1", true},
+		{ip_value_network, "2001:db8::/129", false},
+		{ip_value_network, "10.0.0.1-10.0.0.9", false},
+		{ip_value_range, "10.0.0.1-10.0.0.9", true},
+		{ip_value_range, "2001:db8::1-2001:db8::9", true},
+		{ip_value_range, "10.0.0.1-2001:db8::9", false},
+		{ip_value_range, "10.0.0.1", false},
+		{ip_value_range, "10.0.0.9-10.0.0.1", false},
+		{ip_value_range, "10.0.0.1-10.0.0.1", true},
+	} {
+		_, errs := validateIPValue(tc.kind)(tc.value, "value")
+		if valid := len(errs) == 0; valid != tc.valid {
+			t.Errorf("expected %s %q to be valid: %t, got errors: %v", tc.kind, tc.value, tc.valid, errs)
+		}
+	}
+}
+
+func TestEquivalentIPValues(t *testing.T) {
+	for _, tc := range []struct {
+		a, b       string
+		equivalent bool
+	}{
+		{"10.0.0.1", "10.0.0.1", true},
+		{"2001:DB8:0:0:0:0:0:1", "2001:db8::1", true},
+		{"2001:db8:0::/32", "2001:db8::/32", true},
+		{"2001:db8::/32", "2001:db8::/48", false},
+		{"2001:db8::0001-2001:db8::0009", "2001:db8::1-2001:db8::9", true},
+		{"2001:db8::1", "2001:db8::2", false},
+		{"web-server", "WEB-server", true},
+		{"web-server", "10.0.0.1", false},
+	} {
+		if equivalent := equivalentIPValues(tc.a, tc.b); equivalent != tc.equivalent {
+			t.Errorf("expected %q and %q to be equivalent: %t", tc.a, tc.b, tc.equivalent)
+		}
+	}
+}
+
+func TestMappedName(t *testing.T) {
+	c := &Client{nameMapping: map[string]string{
+		"zone:inside_dev": "inside_prod",
+		"inside_dev":      "inside",
+		"web_dev":         "web_prod",
+	}}
+	for _, test := range []struct {
+		kind, name, expected string
+	}{
+		{"zone", "inside_dev", "inside_prod"},
+		{"network", "inside_dev", "inside"},
+		{"host", "web_dev", "web_prod"},
+		{"zone", "outside", "outside"},
+	} {
+		if mapped := c.mappedName(test.kind, test.name); mapped != test.expected {
+			t.Errorf("expected %s:%s to be mapped to %s, got %s", test.kind, test.name, test.expected, mapped)
+		}
+	}
+}
+
+func TestOrderLikeState(t *testing.T) {
+	member := func(id string) interface{} {
+		return map[string]interface{}{"id": id}
+	}
+	state := []interface{}{member("a"), member("b"), member("c")}
+	read := []interface{}{member("d"), member("c"), member("a"), member("b")}
+	ordered := orderLikeState(state, read, func(state, read map[string]interface{}) bool {
+		return state["id"] == read["id"]
+	})
+	expected := []interface{}{member("a"), member("b"), member("c"), member("d")}
+	if !reflect.DeepEqual(ordered, expected) {
+		t.Errorf("expected %v, got %v", expected, ordered)
+	}
+}
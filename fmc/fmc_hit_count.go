@@ -0,0 +1,61 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// API paths of the policies with hit counts, keyed by the policy type
+var hit_count_policy_paths = map[string]string{
+	"AccessPolicy":    "policy/accesspolicies",
+	"PrefilterPolicy": "policy/prefilterpolicies",
+}
+
+type HitCount struct {
+	Hitcount           int    `json:"hitCount"`
+	Firsthittimestamp  string `json:"firstHitTimeStamp"`
+	Lasthittimestamp   string `json:"lastHitTimeStamp"`
+	Lastfetchtimestamp string `json:"lastFetchTimeStamp"`
+	Rule               struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"rule"`
+}
+
+type HitCountsResponse struct {
+	Items  []HitCount `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcHitCounts returns the hit counts of the rules of the policy on the device
+func (v *Client) GetFmcHitCounts(ctx context.Context, policyType, policyId, deviceId string) ([]HitCount, error) {
+	path, ok := hit_count_policy_paths[policyType]
+	if !ok {
+		return nil, fmt.Errorf("getting hit counts: unsupported policy type %s", policyType)
+	}
+	hitCounts := []HitCount{}
+	for offset := 0; ; {
+		url := v.buildURL(fmt.Sprintf("%s/%s/operational/hitcounts", path, policyId), NewQuery().Expanded(true).Offset(offset).Limit(fmc_query_limit).Filter("deviceId", deviceId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting hit counts: %s - %s", url, err.Error())
+		}
+		res := &HitCountsResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting hit counts: %s - %s", url, err.Error())
+		}
+		hitCounts = append(hitCounts, res.Items...)
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return hitCounts, nil
+		}
+	}
+}
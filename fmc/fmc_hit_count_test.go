@@ -0,0 +1,36 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetFmcHitCounts(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/policy/prefilterpolicies/policy/operational/hitcounts") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if filter := r.URL.Query().Get("filter"); filter != "deviceId:device" {
+			t.Errorf("unexpected filter %s", filter)
+		}
+		// One hit count per page, to check the paging
+		offset := 0
+		_, _ = fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"items": [{"hitCount": %d, "rule": {"id": "rule%d", "name": "Rule %d"}}], "paging": {"count": 2}}`, offset*10, offset, offset)
+	})
+
+	hitCounts, err := c.GetFmcHitCounts(context.Background(), "PrefilterPolicy", "policy", "device")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hitCounts) != 2 || hitCounts[1].Rule.ID != "rule1" || hitCounts[1].Hitcount != 10 {
+		t.Errorf("unexpected hit counts %+v", hitCounts)
+	}
+	if _, err := c.GetFmcHitCounts(context.Background(), "NatPolicy", "policy", "device"); err == nil {
+		t.Error("expected an error for an unsupported policy type")
+	}
+}
@@ -71,8 +71,8 @@ type HostObjectsResponse struct {
 	} `json:"paging"`
 }
 
-func (v *Client) GetFmcHostObjectByNameOrValue(ctx context.Context, nameOrValue string) (*HostObjectResponse, error) {
-	url := fmt.Sprintf("%s/object/hosts?expanded=true&filter=nameOrValue:%s", v.domainBaseURL, nameOrValue)
+func (v *Client) GetFmcHostObjectByNameOrValue(ctx context.Context, nameOrValue string, filters map[string]string) (*HostObjectResponse, error) {
+	url := v.buildURL("object/hosts", NewQuery().Expanded(true).Filter("nameOrValue", nameOrValue).Filters(filters))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting host object by name/value: %s - %s", url, err.Error())
@@ -87,7 +87,7 @@ func (v *Client) GetFmcHostObjectByNameOrValue(ctx context.Context, nameOrValue
 		return v.GetFmcHostObject(ctx, resp.Items[0].ID)
 	case l > 1:
 		for _, item := range resp.Items {
-			if item.Name == nameOrValue || item.Value == nameOrValue {
+			if item.Name == nameOrValue || equivalentIPValues(item.Value, nameOrValue) {
 				return v.GetFmcHostObject(ctx, item.ID)
 			}
 		}
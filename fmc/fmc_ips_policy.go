@@ -33,7 +33,7 @@ type IPSPolicy struct {
 }
 
 func (v *Client) GetFmcIPSPolicyByName(ctx context.Context, name string) (*IPSPolicy, error) {
-	url := fmt.Sprintf("%s/policy/intrusionpolicies", v.domainBaseURL)
+	url := v.buildURL("policy/intrusionpolicies", NewQuery().Limit(fmc_query_limit))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting IPS policy by name: %s - %s", url, err.Error())
@@ -55,3 +55,26 @@ func (v *Client) GetFmcIPSPolicyByName(ctx context.Context, name string) (*IPSPo
 	}
 	return nil, fmt.Errorf("no IPS policy found with name %s", name)
 }
+
+func (v *Client) GetFmcIPSPolicies(ctx context.Context) ([]IPSPolicy, error) {
+	url := v.buildURL("policy/intrusionpolicies", NewQuery().Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting IPS policies: %s - %s", url, err.Error())
+	}
+	ipsPolicies := &IPSPoliciesResponse{}
+	err = v.DoRequest(req, ipsPolicies, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting IPS policies: %s - %s", url, err.Error())
+	}
+
+	policies := make([]IPSPolicy, 0, len(ipsPolicies.Items))
+	for _, ipsPolicy := range ipsPolicies.Items {
+		policies = append(policies, IPSPolicy{
+			ID:   ipsPolicy.ID,
+			Name: ipsPolicy.Name,
+			Type: ipsPolicy.Type,
+		})
+	}
+	return policies, nil
+}
@@ -0,0 +1,82 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type IPSPolicyRuleAction struct {
+	Policy struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"policy"`
+	Defaultstate  string `json:"defaultState"`
+	Overridestate string `json:"overrideState"`
+}
+
+type IPSPolicyRule struct {
+	ID         string                `json:"id"`
+	Name       string                `json:"name"`
+	Type       string                `json:"type"`
+	Gid        int                   `json:"gid"`
+	Sid        int                   `json:"sid"`
+	Msg        string                `json:"msg"`
+	Ruleaction []IPSPolicyRuleAction `json:"ruleAction"`
+}
+
+type IPSPolicyRulesResponse struct {
+	Items  []IPSPolicyRule `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcIPSPolicyRules returns all the intrusion rules with their state in the given intrusion policy
+func (v *Client) GetFmcIPSPolicyRules(ctx context.Context, ipsPolicyId string) ([]IPSPolicyRule, error) {
+	rules := []IPSPolicyRule{}
+	for offset := 0; ; {
+		url := v.buildURL("object/intrusionrules", NewQuery().Expanded(true).Offset(offset).Limit(fmc_query_limit).Filter("ipspolicy", ipsPolicyId))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting IPS policy rules: %s - %s", url, err.Error())
+		}
+		res := &IPSPolicyRulesResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting IPS policy rules: %s - %s", url, err.Error())
+		}
+		rules = append(rules, res.Items...)
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return rules, nil
+		}
+	}
+}
+
+// Overridden checks if the state of the rule is overridden in the given intrusion policy
+func (r *IPSPolicyRule) Overridden(ipsPolicyId string) bool {
+	for _, action := range r.Ruleaction {
+		if action.Policy.ID == "" || action.Policy.ID == ipsPolicyId {
+			return action.Overridestate != ""
+		}
+	}
+	return false
+}
+
+// State returns the effective state of the rule in the given intrusion policy, the override if one is set
+func (r *IPSPolicyRule) State(ipsPolicyId string) string {
+	for _, action := range r.Ruleaction {
+		if action.Policy.ID != "" && action.Policy.ID != ipsPolicyId {
+			continue
+		}
+		if action.Overridestate != "" {
+			return action.Overridestate
+		}
+		return action.Defaultstate
+	}
+	return ""
+}
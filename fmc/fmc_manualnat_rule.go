@@ -82,20 +82,9 @@ type ManualNatRuleResponse struct {
 // /fmc_config/v1/domain/DomainUUID/policy/ftdnatpolicies/{containerUUID}/manualnatrules?bulk=true ( Bulk POST operation on manual nat rules. )
 
 func (v *Client) CreateFmcManualNatRule(ctx context.Context, natId, section, targetIndex string, manualNatRule *ManualNatRule) (*ManualNatRuleResponse, error) {
-	url := fmt.Sprintf("%s/policy/ftdnatpolicies/%s/manualnatrules", v.domainBaseURL, natId)
-	initialSet := false
-	if section != "" {
-		url = fmt.Sprintf("%s?section=%s", url, section)
-		initialSet = true
-	}
-	if targetIndex != "" {
-		if initialSet {
-			url = fmt.Sprintf("%s&targetIndex=%s", url, targetIndex)
-		} else {
-			url = fmt.Sprintf("%s?targetIndex=%s", url, targetIndex)
-			initialSet = true
-		}
-	}
+	url := v.buildURL(fmt.Sprintf("policy/ftdnatpolicies/%s/manualnatrules", natId), NewQuery().
+		Set("section", section).
+		Set("targetIndex", targetIndex))
 	body, err := json.Marshal(&manualNatRule)
 	if err != nil {
 		return nil, fmt.Errorf("creating manual nat rules: %s - %s", url, err.Error())
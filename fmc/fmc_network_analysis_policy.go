@@ -0,0 +1,31 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type NetworkAnalysisPolicy struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type NetworkAnalysisPoliciesResponse struct {
+	Items []NetworkAnalysisPolicy `json:"items"`
+}
+
+func (v *Client) GetFmcNetworkAnalysisPolicies(ctx context.Context) ([]NetworkAnalysisPolicy, error) {
+	url := v.buildURL("policy/networkanalysispolicies", NewQuery().Limit(fmc_query_limit))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting network analysis policies: %s - %s", url, err.Error())
+	}
+	res := &NetworkAnalysisPoliciesResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting network analysis policies: %s - %s", url, err.Error())
+	}
+	return res.Items, nil
+}
@@ -18,7 +18,7 @@ type NetworkGroupObjectUpdateInput struct {
 }
 
 type NetworkGroupObjectObjects struct {
-	Name string `json:"name"`
+	Name string `json:"name,omitempty"`
 	Type string `json:"type"`
 	ID   string `json:"id"`
 }
@@ -120,3 +120,95 @@ func (v *Client) DeleteFmcNetworkGroupObject(ctx context.Context, id string) err
 	err = v.DoRequest(req, nil, http.StatusOK)
 	return err
 }
+
+type NetworkGroupObjectsResponse struct {
+	Items []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"items"`
+}
+
+func (v *Client) GetFmcNetworkGroupObjectByName(ctx context.Context, name string) (*NetworkGroupObjectResponse, error) {
+	url := v.buildURL("object/networkgroups", NewQuery().Limit(fmc_query_limit).Filter("nameOrValue", name))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting network group object by name: %s - %s", url, err.Error())
+	}
+	resp := &NetworkGroupObjectsResponse{}
+	err = v.DoRequest(req, resp, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting network group object by name: %s - %s", url, err.Error())
+	}
+	for _, item := range resp.Items {
+		if item.Name == name {
+			return v.GetFmcNetworkGroupObject(ctx, item.ID)
+		}
+	}
+	return nil, fmt.Errorf("no network group object found with name %s", name)
+}
+
+// GetFmcNetworkGroupObjectValues walks the network group and its nested groups, and returns the values of all
+// the objects and literals in it. Each value is returned once, in the order it is first found.
+func (v *Client) GetFmcNetworkGroupObjectValues(ctx context.Context, id string) ([]string, error) {
+	values := []string{}
+	seen := map[string]bool{}
+	visited := map[string]bool{}
+	var walk func(id string) error
+	walk = func(id string) error {
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+		group, err := v.GetFmcNetworkGroupObject(ctx, id)
+		if err != nil {
+			return err
+		}
+		add := func(value string) {
+			if !seen[value] {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+		for _, literal := range group.Literals {
+			add(literal.Value)
+		}
+		for _, object := range group.Objects {
+			switch object.Type {
+			case network_group_type:
+				if err := walk(object.ID); err != nil {
+					return err
+				}
+			case "Host":
+				host, err := v.GetFmcHostObject(ctx, object.ID)
+				if err != nil {
+					return err
+				}
+				add(host.Value)
+			case "Range":
+				rangeObject, err := v.GetFmcRangeObject(ctx, object.ID)
+				if err != nil {
+					return err
+				}
+				add(rangeObject.Value)
+			case "FQDN":
+				fqdn, err := v.GetFmcFQDNObject(ctx, object.ID)
+				if err != nil {
+					return err
+				}
+				add(fqdn.Value)
+			default:
+				network, err := v.GetFmcNetworkObject(ctx, object.ID)
+				if err != nil {
+					return err
+				}
+				add(network.Value)
+			}
+		}
+		return nil
+	}
+	if err := walk(id); err != nil {
+		return nil, fmt.Errorf("getting network group object values: %s - %s", id, err.Error())
+	}
+	return values, nil
+}
@@ -43,8 +43,8 @@ type NetworkObjectsResponse struct {
 	} `json:"items"`
 }
 
-func (v *Client) GetFmcNetworkObjectByNameOrValue(ctx context.Context, nameOrValue string) (*NetworkObjectResponse, error) {
-	url := fmt.Sprintf("%s/object/networks?expanded=true&limit=1000&filter=nameOrValue:%s", v.domainBaseURL, nameOrValue)
+func (v *Client) GetFmcNetworkObjectByNameOrValue(ctx context.Context, nameOrValue string, filters map[string]string) (*NetworkObjectResponse, error) {
+	url := v.buildURL("object/networks", NewQuery().Expanded(true).Limit(fmc_query_limit).Filter("nameOrValue", nameOrValue).Filters(filters))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting network object by name/value: %s - %s", url, err.Error())
@@ -59,7 +59,7 @@ func (v *Client) GetFmcNetworkObjectByNameOrValue(ctx context.Context, nameOrVal
 		return v.GetFmcNetworkObject(ctx, resp.Items[0].ID)
 	case l > 1:
 		for _, item := range resp.Items {
-			if item.Name == nameOrValue || item.Value == nameOrValue {
+			if item.Name == nameOrValue || equivalentIPValues(item.Value, nameOrValue) {
 				return v.GetFmcNetworkObject(ctx, item.ID)
 			}
 		}
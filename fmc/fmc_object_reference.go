@@ -0,0 +1,55 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// API paths of the referenceable objects, keyed by the type returned by FMC
+var object_reference_paths = map[string]string{
+	"SecurityZone":        "object/securityzones",
+	"InterfaceGroup":      "object/interfacegroups",
+	"TunnelTag":           "object/tunneltags",
+	"Network":             "object/networks",
+	"Host":                "object/hosts",
+	"Range":               "object/ranges",
+	"FQDN":                "object/fqdns",
+	"NetworkGroup":        "object/networkgroups",
+	"ProtocolPortObject":  "object/protocolportobjects",
+	"PortObjectGroup":     "object/portobjectgroups",
+	"ICMPV4Object":        "object/icmpv4objects",
+	"Url":                 "object/urls",
+	"UrlGroup":            "object/urlgroups",
+	"DynamicObject":       "object/dynamicobjects",
+	"URLCategory":         "object/urlcategories",
+	"SecurityGroupTag":    "object/securitygrouptags",
+	"ISESecurityGroupTag": "object/isesecuritygrouptags",
+	"Application":         "object/applications",
+	"RealmUser":           "object/realmusers",
+	"RealmUserGroup":      "object/realmusergroups",
+	"VlanTag":             "object/vlantags",
+	"VlanGroupTag":        "object/vlangrouptags",
+	"IntrusionPolicy":     "policy/intrusionpolicies",
+	"FilePolicy":          "policy/filepolicies",
+	"SyslogAlert":         "policy/syslogalerts",
+}
+
+// CheckFmcObjectReference returns an error if the object with the given type and ID does not exist.
+// Types without a known path are not checked.
+func (v *Client) CheckFmcObjectReference(ctx context.Context, objectType, id string) error {
+	path, ok := object_reference_paths[objectType]
+	if !ok {
+		return nil
+	}
+	url := fmt.Sprintf("%s/%s/%s", v.domainBaseURL, path, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("getting object reference: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("getting object reference: %s - %s", url, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type PolicyBasedRouteSubConfig struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type PolicyBasedRouteForwardingAction struct {
+	Forwardingactiontype string                      `json:"forwardingActionType"`
+	Matchcriteria        PolicyBasedRouteSubConfig   `json:"matchCriteria"`
+	Egressinterfaces     []PolicyBasedRouteSubConfig `json:"egressInterfaces"`
+	Interfaceordertype   string                      `json:"interfaceOrderType"`
+}
+
+type PolicyBasedRoute struct {
+	ID                string                             `json:"id,omitempty"`
+	Name              string                             `json:"name,omitempty"`
+	Type              string                             `json:"type"`
+	Ingressinterfaces []PolicyBasedRouteSubConfig        `json:"ingressInterfaces"`
+	Forwardingactions []PolicyBasedRouteForwardingAction `json:"forwardingActions"`
+}
+
+type PolicyBasedRouteResponse struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	Ingressinterfaces []struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"ingressInterfaces"`
+	Forwardingactions []struct {
+		Forwardingactiontype string `json:"forwardingActionType"`
+		Matchcriteria        struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+			Name string `json:"name"`
+		} `json:"matchCriteria"`
+		Egressinterfaces []struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+			Name string `json:"name"`
+		} `json:"egressInterfaces"`
+		Interfaceordertype string `json:"interfaceOrderType"`
+	} `json:"forwardingActions"`
+}
+
+func (v *Client) CreateFmcPolicyBasedRoute(ctx context.Context, deviceID string, object *PolicyBasedRoute) (*PolicyBasedRouteResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/policybasedroutes", v.domainBaseURL, deviceID)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating policy based routes: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating policy based routes: %s - %s", url, err.Error())
+	}
+	item := &PolicyBasedRouteResponse{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating policy based routes: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcPolicyBasedRoute(ctx context.Context, deviceID, id string) (*PolicyBasedRouteResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/policybasedroutes/%s", v.domainBaseURL, deviceID, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting policy based routes: %s - %s", url, err.Error())
+	}
+	item := &PolicyBasedRouteResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting policy based routes: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcPolicyBasedRoute(ctx context.Context, deviceID, id string, object *PolicyBasedRoute) (*PolicyBasedRouteResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/policybasedroutes/%s", v.domainBaseURL, deviceID, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating policy based routes: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating policy based routes: %s - %s", url, err.Error())
+	}
+	item := &PolicyBasedRouteResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating policy based routes: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcPolicyBasedRoute(ctx context.Context, deviceID, id string) error {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/policybasedroutes/%s", v.domainBaseURL, deviceID, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting policy based routes: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
@@ -83,3 +83,32 @@ func (v *Client) UpdateFmcPolicyDevicesAssignment(ctx context.Context, id string
 	}
 	return item, nil
 }
+
+type PolicyDevicesAssignmentsResponse struct {
+	Items  []PolicyDevicesAssignment `json:"items"`
+	Paging struct {
+		Count int `json:"count"`
+	} `json:"paging"`
+}
+
+// GetFmcPolicyDevicesAssignments returns the device assignments of all the policies
+func (v *Client) GetFmcPolicyDevicesAssignments(ctx context.Context) ([]PolicyDevicesAssignment, error) {
+	assignments := []PolicyDevicesAssignment{}
+	for offset := 0; ; {
+		url := v.buildURL("assignment/policyassignments", NewQuery().Expanded(true).Offset(offset).Limit(fmc_query_limit))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting device policy assignments: %s - %s", url, err.Error())
+		}
+		res := &PolicyDevicesAssignmentsResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting device policy assignments: %s - %s", url, err.Error())
+		}
+		assignments = append(assignments, res.Items...)
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return assignments, nil
+		}
+	}
+}
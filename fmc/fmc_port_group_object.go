@@ -17,7 +17,7 @@ type PortGroupObjectUpdateInput struct {
 }
 
 type PortGroupObjectObjects struct {
-	Name string `json:"name"`
+	Name string `json:"name,omitempty"`
 	Type string `json:"type"`
 	ID   string `json:"id"`
 }
@@ -46,8 +46,8 @@ type PortObjectsResponse struct {
 	} `json:"items"`
 }
 
-func (v *Client) GetFmcPortObjectByNameOrPort(ctx context.Context, nameOrPort string) (*PortObjectResponse, error) {
-	url := fmt.Sprintf("%s/object/protocolportobjects?expanded=false&filter=nameOrValue:%s", v.domainBaseURL, nameOrPort)
+func (v *Client) GetFmcPortObjectByNameOrPort(ctx context.Context, nameOrPort string, filters map[string]string) (*PortObjectResponse, error) {
+	url := v.buildURL("object/protocolportobjects", NewQuery().Expanded(false).Filter("nameOrValue", nameOrPort).Filters(filters))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting port object by name/port: %s - %s", url, err.Error())
@@ -0,0 +1,48 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type PrefilterRule struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Action   string `json:"action"`
+	Ruletype string `json:"ruleType"`
+	Enabled  bool   `json:"enabled"`
+}
+
+type PrefilterRulesResponse struct {
+	Items  []PrefilterRule `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+// GetFmcPrefilterRules returns the prefilter and tunnel rules of the prefilter policy in order
+func (v *Client) GetFmcPrefilterRules(ctx context.Context, policyId string) ([]PrefilterRule, error) {
+	rules := []PrefilterRule{}
+	for offset := 0; ; {
+		url := v.buildURL(fmt.Sprintf("policy/prefilterpolicies/%s/prefilterrules", policyId), NewQuery().Expanded(true).Offset(offset).Limit(fmc_query_limit))
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting prefilter rules: %s - %s", url, err.Error())
+		}
+		res := &PrefilterRulesResponse{}
+		err = v.DoRequest(req, res, http.StatusOK)
+		if err != nil {
+			return nil, fmt.Errorf("getting prefilter rules: %s - %s", url, err.Error())
+		}
+		rules = append(rules, res.Items...)
+		offset += len(res.Items)
+		if len(res.Items) == 0 || offset >= res.Paging.Count {
+			return rules, nil
+		}
+	}
+}
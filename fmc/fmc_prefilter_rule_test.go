@@ -0,0 +1,31 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetFmcPrefilterRules(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/policy/prefilterpolicies/policy/prefilterrules") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		// One rule per page, to check the paging
+		offset := 0
+		_, _ = fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		action := []string{"FASTPATH", "ANALYZE"}[offset]
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"items": [{"id": "rule%d", "name": "Rule %d", "action": "%s", "enabled": true}], "paging": {"count": 2}}`, offset, offset, action)
+	})
+
+	rules, err := c.GetFmcPrefilterRules(context.Background(), "policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].Action != "FASTPATH" || rules[1].ID != "rule1" {
+		t.Errorf("unexpected rules %+v", rules)
+	}
+}
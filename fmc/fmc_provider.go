@@ -3,6 +3,7 @@ package fmc
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,17 +16,66 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	insecureSkipVerify := d.Get("fmc_insecure_skip_verify").(bool)
 	var diags diag.Diagnostics
 
+	// The stop context is cancelled when terraform stops the provider
+	stopCtx, ok := schema.StopContext(ctx)
+	if !ok {
+		stopCtx = context.Background()
+	}
+	startAPIStatisticsLogging(stopCtx)
+
 	if username != "" && password != "" && host != "" {
 		client := NewClient(username, password, host, insecureSkipVerify)
-		err := client.Login()
+		client.strictDecoding = d.Get("fmc_strict_decoding").(bool)
+		client.descriptionMarker = d.Get("fmc_description_marker").(string)
+		registerDescriptionMarker(client.descriptionMarker)
+		client.defaultCommentTemplate = d.Get("fmc_default_comment").(string)
+		client.nameMapping = map[string]string{}
+		for name, mapped := range d.Get("fmc_name_mapping").(map[string]interface{}) {
+			client.nameMapping[name] = mapped.(string)
+		}
+		err := client.Login(ctx)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
+		readOnlyUsername := d.Get("fmc_read_only_username").(string)
+		readOnlyPassword := d.Get("fmc_read_only_password").(string)
+		if (readOnlyUsername == "") != (readOnlyPassword == "") {
+			return nil, diag.FromErr(errors.New("missing fmc read-only username or password, both or none must be set"))
+		}
+		if readOnlyUsername != "" {
+			reader := NewClient(readOnlyUsername, readOnlyPassword, host, insecureSkipVerify)
+			reader.strictDecoding = client.strictDecoding
+			if err := reader.Login(ctx); err != nil {
+				return nil, diag.FromErr(fmt.Errorf("read-only user: %s", err.Error()))
+			}
+			// The requests are sent to the domain of the read-write user
+			if reader.domainUUID != client.domainUUID {
+				return nil, diag.FromErr(fmt.Errorf("the read-only user logs in to the domain %s instead of %s of the read-write user", reader.domainUUID, client.domainUUID))
+			}
+			client.reader = reader
+		}
 		return client, diags
 	}
 	return nil, diag.FromErr(errors.New("missing fmc username, password or base url"))
 }
 
+// Renamed resources, the old names keep working as deprecated aliases of the new ones
+// so that configurations can be migrated gradually
+var resource_aliases = map[string]string{
+	"fmc_access_policies": "fmc_access_policy",
+}
+
+// withResourceAliases adds the deprecated aliases of the renamed resources. The aliases share the
+// CRUD implementation and IDs with the new resources, so they can be moved by importing the same ID.
+func withResourceAliases(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for alias, name := range resource_aliases {
+		resource := *resources[name]
+		resource.DeprecationMessage = fmt.Sprintf("%s is deprecated, use %s instead. Existing resources can be migrated by removing them from the state and importing them into %s with the same ID.", alias, name, name)
+		resources[alias] = &resource
+	}
+	return resources
+}
+
 // Provider
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -56,8 +106,48 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("FMC_INSECURE_SKIP_VERIFY", false),
 				Description: "Skip certificate checks if the certificate is not public CA signed, or if using IP address",
 			},
+			"fmc_description_marker": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FMC_DESCRIPTION_MARKER", ""),
+				Description: "Marker appended to the descriptions of the objects created by terraform, e.g. \"managed-by-terraform workspace=prod\"",
+			},
+			"fmc_default_comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FMC_DEFAULT_COMMENT", ""),
+				Description: "Comment added to the access rules created or updated without new_comments, {name} and {operation} are replaced by the rule name and \"create\" or \"update\", e.g. \"{operation} of {name} by terraform\"",
+			},
+			"fmc_name_mapping": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the objects in this FMC used by the data sources instead of the configured names, keyed by the configured name or by \"<kind>:<name>\" for one kind only, e.g. { \"zone:inside_dev\" = \"inside_prod\" }. The kinds are zone, network, host, range, fqdn, network_group, port, url, access_policy, ips_policy, file_policy, syslog_alert, device, dns_server_group, dynamic_object and platform_settings",
+			},
+			"fmc_strict_decoding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FMC_STRICT_DECODING", false),
+				Description: "Fail on the fields returned by FMC which are unknown to the provider, e.g. to find the attributes added by newer FMC versions which are dropped silently otherwise",
+			},
+			"fmc_read_only_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("FMC_READ_ONLY_USERNAME", ""),
+				Description: "Username of a read-only user to login to FMC, used for all the reads instead of fmc_username",
+			},
+			"fmc_read_only_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("FMC_READ_ONLY_PASSWORD", ""),
+				Description: "Password of the read-only user to login to FMC",
+			},
 		},
-		ResourcesMap: map[string]*schema.Resource{
+		ResourcesMap: withResourceAliases(map[string]*schema.Resource{
 			"fmc_url_objects":                resourceFmcURLObjects(),
 			"fmc_url_object_group":           resourceFmcURLObjectGroup(),
 			"fmc_port_objects":               resourceFmcPortObjects(),
@@ -67,7 +157,7 @@ func Provider() *schema.Provider {
 			"fmc_fqdn_objects":               resourceFmcFQDNObjects(),
 			"fmc_icmpv4_objects":             resourceFmcICMPV4Objects(),
 			"fmc_access_rules":               resourceFmcAccessRules(),
-			"fmc_access_policies":            resourceFmcAccessPolicies(),
+			"fmc_access_policy":              resourceFmcAccessPolicies(),
 			"fmc_network_group_objects":      resourceFmcNetworkGroupObjects(),
 			"fmc_port_group_objects":         resourceFmcPortGroupObjects(),
 			"fmc_ftd_nat_policies":           resourceFmcNatPolicies(),
@@ -75,25 +165,72 @@ func Provider() *schema.Provider {
 			"fmc_ftd_manualnat_rules":        resourceFmcManualNatRules(),
 			"fmc_policy_devices_assignments": resourceFmcPolicyDevicesAssignments(),
 			"fmc_ftd_deploy":                 resourceFmcFtdDeploy(),
+			"fmc_staged_changes":             resourceFmcStagedChanges(),
+			"fmc_ftd_snmp":                   resourceFmcFTDSNMP(),
+			"fmc_ftd_timezone":               resourceFmcFTDTimezone(),
+			"fmc_ftd_dns":                    resourceFmcFTDDNS(),
+			"fmc_bulk_objects":               resourceFmcBulkObjects(),
+			"fmc_ftd_s2s_vpn_psk":            resourceFmcFTDS2SVPNPSK(),
 			"fmc_dynamic_object":             resourceFmcDynamicObjects(),
 			"fmc_dynamic_object_mapping":     resourceFmcDynamicObjectMapping(),
 			"fmc_security_zone":              resourceFmcSecurityZone(),
 			"fmc_time_range_object":          resourceFmcTimeRangeObject(),
+			"fmc_timezone_objects":           resourceFmcTimezoneObjects(),
+			"fmc_dns_server_groups":          resourceFmcDNSServerGroups(),
 			"fmc_access_policies_category":   resourceFmcAccessPoliciesCategory(),
 			"fmc_prefilter_policy":           resourceFmcPrefilterPolicy(),
-		},
+			"fmc_vtep_policies":              resourceFmcVTEPPolicies(),
+			"fmc_vni_interfaces":             resourceFmcVNIInterfaces(),
+			"fmc_devices":                    resourceFmcDevices(),
+			"fmc_device_registration":        resourceFmcDeviceRegistration(),
+			"fmc_device_licenses":            resourceFmcDeviceLicenses(),
+			"fmc_device_interface_sync":      resourceFmcDeviceInterfaceSync(),
+			"fmc_troubleshoot_files":         resourceFmcTroubleshootFiles(),
+			"fmc_content_update":             resourceFmcContentUpdate(),
+			"fmc_data_purge":                 resourceFmcDataPurge(),
+			"fmc_text_objects":               resourceFmcTextObjects(),
+			"fmc_flexconfig_objects":         resourceFmcFlexConfigObjects(),
+			"fmc_flexconfig_policies":        resourceFmcFlexConfigPolicies(),
+			"fmc_device_policy_based_routes": resourceFmcDevicePolicyBasedRoutes(),
+			"fmc_device_groups":              resourceFmcDeviceGroups(),
+			"fmc_object_sync":                resourceFmcObjectSync(),
+		}),
 		DataSourcesMap: map[string]*schema.Resource{
-			"fmc_devices":         dataSourceFmcDevices(),
-			"fmc_access_policies": dataSourceFmcAccessPolicies(),
-			"fmc_ips_policies":    dataSourceFmcIPSPolicies(),
-			"fmc_file_policies":   dataSourceFmcFilePolicies(),
-			"fmc_syslog_alerts":   dataSourceFmcSyslogAlerts(),
-			"fmc_security_zones":  dataSourceFmcSecurityZones(),
-			"fmc_network_objects": dataSourceFmcNetworkObjects(),
-			"fmc_host_objects":    dataSourceFmcHostObjects(),
-			"fmc_url_objects":     dataSourceFmcURLObjects(),
-			"fmc_port_objects":    dataSourceFmcPortObjects(),
-			"fmc_dynamic_objects": dataSourceFmcDynamicObjects(),
+			"fmc_devices":                        dataSourceFmcDevices(),
+			"fmc_access_policies":                dataSourceFmcAccessPolicies(),
+			"fmc_access_rules":                   dataSourceFmcAccessRules(),
+			"fmc_access_rule":                    dataSourceFmcAccessRule(),
+			"fmc_ftd_autonat_rules":              dataSourceFmcAutoNatRules(),
+			"fmc_hit_counts":                     dataSourceFmcHitCounts(),
+			"fmc_prefilter_fastpath_statistics":  dataSourceFmcPrefilterFastpathStatistics(),
+			"fmc_ips_policies":                   dataSourceFmcIPSPolicies(),
+			"fmc_ips_policy_rules":               dataSourceFmcIPSPolicyRules(),
+			"fmc_file_policies":                  dataSourceFmcFilePolicies(),
+			"fmc_syslog_alerts":                  dataSourceFmcSyslogAlerts(),
+			"fmc_security_zones":                 dataSourceFmcSecurityZones(),
+			"fmc_network_objects":                dataSourceFmcNetworkObjects(),
+			"fmc_network_group_objects":          dataSourceFmcNetworkGroupObjects(),
+			"fmc_range_objects":                  dataSourceFmcRangeObjects(),
+			"fmc_fqdn_objects":                   dataSourceFmcFQDNObjects(),
+			"fmc_dns_server_groups":              dataSourceFmcDNSServerGroups(),
+			"fmc_host_objects":                   dataSourceFmcHostObjects(),
+			"fmc_url_objects":                    dataSourceFmcURLObjects(),
+			"fmc_port_objects":                   dataSourceFmcPortObjects(),
+			"fmc_dynamic_objects":                dataSourceFmcDynamicObjects(),
+			"fmc_device_physical_interfaces":     dataSourceFmcDevicePhysicalInterfaces(),
+			"fmc_extended_access_lists":          dataSourceFmcExtendedAccessLists(),
+			"fmc_ftd_platform_settings_policies": dataSourceFmcFTDPlatformSettingsPolicies(),
+			"fmc_ftd_s2s_vpns":                   dataSourceFmcFTDS2SVPNs(),
+			"fmc_anyconnect_custom_attributes":   dataSourceFmcAnyConnectCustomAttributes(),
+			"fmc_hostscan_packages":              dataSourceFmcHostScanPackages(),
+			"fmc_ftd_deploy_impact":              dataSourceFmcFtdDeployImpact(),
+			"fmc_domain_audit":                   dataSourceFmcDomainAudit(),
+			"fmc_device_clusters":                dataSourceFmcDeviceClusters(),
+			"fmc_device_cluster_nodes":           dataSourceFmcDeviceClusterNodes(),
+			"fmc_device_ha_pairs":                dataSourceFmcDeviceHAPairs(),
+			"fmc_device_commands":                dataSourceFmcDeviceCommands(),
+			"fmc_base_policies":                  dataSourceFmcBasePolicies(),
+			"fmc_system_information":             dataSourceFmcSystemInformation(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
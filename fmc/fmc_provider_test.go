@@ -23,6 +23,21 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderResourceAliases(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for alias, name := range resource_aliases {
+		if resources[name] == nil {
+			t.Fatalf("resource %s of alias %s is not registered", name, alias)
+		}
+		if resources[alias] == nil || resources[alias].DeprecationMessage == "" {
+			t.Fatalf("alias %s of resource %s is not registered as deprecated", alias, name)
+		}
+		if resources[name].DeprecationMessage != "" {
+			t.Fatalf("resource %s is deprecated by its alias %s", name, alias)
+		}
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
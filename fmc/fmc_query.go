@@ -0,0 +1,100 @@
+package fmc
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The maximum page size of the FMC API list endpoints
+var fmc_query_limit = 1000
+
+// Query builds the query parameters of the FMC API requests, e.g.
+//
+//	v.buildURL("object/networks", NewQuery().Expanded(true).Limit(fmc_query_limit).Filter("nameOrValue", name))
+type Query struct {
+	values  url.Values
+	filters []string
+}
+
+func NewQuery() *Query {
+	return &Query{values: url.Values{}}
+}
+
+func (q *Query) Expanded(expanded bool) *Query {
+	q.values.Set("expanded", strconv.FormatBool(expanded))
+	return q
+}
+
+func (q *Query) Limit(limit int) *Query {
+	q.values.Set("limit", strconv.Itoa(limit))
+	return q
+}
+
+func (q *Query) Offset(offset int) *Query {
+	q.values.Set("offset", strconv.Itoa(offset))
+	return q
+}
+
+// Filter adds a condition to the filter parameter, FMC expects the conditions as "key:value" separated by ";"
+func (q *Query) Filter(key, value string) *Query {
+	if value != "" {
+		q.filters = append(q.filters, fmt.Sprintf("%s:%s", key, value))
+	}
+	return q
+}
+
+// Filters adds the conditions to the filter parameter, sorted by key so that the URL is stable
+func (q *Query) Filters(filters map[string]string) *Query {
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		q.Filter(key, filters[key])
+	}
+	return q
+}
+
+// Set sets any other query parameter, empty values are omitted
+func (q *Query) Set(key, value string) *Query {
+	if value != "" {
+		q.values.Set(key, value)
+	}
+	return q
+}
+
+// Encode returns the encoded query parameters, sorted by key
+func (q *Query) Encode() string {
+	values := url.Values{}
+	for key, value := range q.values {
+		values[key] = value
+	}
+	if len(q.filters) > 0 {
+		values.Set("filter", strings.Join(q.filters, ";"))
+	}
+	return values.Encode()
+}
+
+// buildURL returns the URL of the path within the domain with the query parameters, query may be nil
+func (v *Client) buildURL(path string, query *Query) string {
+	if query == nil {
+		return fmt.Sprintf("%s/%s", v.domainBaseURL, path)
+	}
+	if encoded := query.Encode(); encoded != "" {
+		return fmt.Sprintf("%s/%s?%s", v.domainBaseURL, path, encoded)
+	}
+	return fmt.Sprintf("%s/%s", v.domainBaseURL, path)
+}
+
+// expandQueryFilters converts the filters map of a data source to the filters of the query
+func expandQueryFilters(filters interface{}) map[string]string {
+	res := map[string]string{}
+	for key, value := range filters.(map[string]interface{}) {
+		res[key] = value.(string)
+	}
+	return res
+}
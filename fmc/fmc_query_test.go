@@ -0,0 +1,37 @@
+package fmc
+
+import (
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	c := &Client{domainBaseURL: "https://fmc/api/fmc_config/v1/domain/default"}
+
+	for _, tc := range []struct {
+		query    *Query
+		expected string
+	}{
+		{nil, "https://fmc/api/fmc_config/v1/domain/default/object/networks"},
+		{NewQuery(), "https://fmc/api/fmc_config/v1/domain/default/object/networks"},
+		{
+			NewQuery().Expanded(true).Limit(fmc_query_limit).Offset(2000),
+			"https://fmc/api/fmc_config/v1/domain/default/object/networks?expanded=true&limit=1000&offset=2000",
+		},
+		{
+			NewQuery().Filter("nameOrValue", "10.0.0.0/8").Filters(map[string]string{"unusedOnly": "true", "type": "Host"}),
+			"https://fmc/api/fmc_config/v1/domain/default/object/networks?filter=nameOrValue%3A10.0.0.0%2F8%3Btype%3AHost%3BunusedOnly%3Atrue",
+		},
+		{
+			NewQuery().Filter("name", "").Set("section", "").Set("insertBefore", "3"),
+			"https://fmc/api/fmc_config/v1/domain/default/object/networks?insertBefore=3",
+		},
+		{
+			NewQuery().Set("name", "my object"),
+			"https://fmc/api/fmc_config/v1/domain/default/object/networks?name=my+object",
+		},
+	} {
+		if url := c.buildURL("object/networks", tc.query); url != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, url)
+		}
+	}
+}
@@ -49,6 +49,55 @@ type RangeObjectResponse struct {
 	} `json:"metadata"`
 }
 
+type RangeObjectsResponse struct {
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+	Items []struct {
+		Links struct {
+			Self   string `json:"self"`
+			Parent string `json:"parent"`
+		} `json:"links"`
+		Type  string `json:"type"`
+		ID    string `json:"id"`
+		Value string `json:"value"`
+		Name  string `json:"name"`
+	} `json:"items"`
+	Paging struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Count  int `json:"count"`
+		Pages  int `json:"pages"`
+	} `json:"paging"`
+}
+
+func (v *Client) GetFmcRangeObjectByNameOrValue(ctx context.Context, nameOrValue string, filters map[string]string) (*RangeObjectResponse, error) {
+	url := v.buildURL("object/ranges", NewQuery().Expanded(true).Filter("nameOrValue", nameOrValue).Filters(filters))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting range object by name/value: %s - %s", url, err.Error())
+	}
+	resp := &RangeObjectsResponse{}
+	err = v.DoRequest(req, resp, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting range object by name/value: %s - %s", url, err.Error())
+	}
+	switch l := len(resp.Items); {
+	case l == 1:
+		return v.GetFmcRangeObject(ctx, resp.Items[0].ID)
+	case l > 1:
+		for _, item := range resp.Items {
+			if item.Name == nameOrValue || equivalentIPValues(item.Value, nameOrValue) {
+				return v.GetFmcRangeObject(ctx, item.ID)
+			}
+		}
+		return nil, fmt.Errorf("duplicates found, no exact match, length of response is: %d, expected 1, please search using a unique id, name or value", l)
+	case l == 0:
+		return nil, fmt.Errorf("no range objects found, length of response is: %d, expected 1, please check your filter", l)
+	}
+	return nil, fmt.Errorf("this should not be reachable, this is a bug")
+}
+
 // /fmc_config/v1/domain/DomainUUID/object/ranges?bulk=true ( Bulk POST operation on range objects. )
 
 func (v *Client) CreateFmcRangeObject(ctx context.Context, object *RangeObject) (*RangeObjectResponse, error) {
@@ -0,0 +1,105 @@
+package fmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type ServerVersion struct {
+	Serverversion string `json:"serverVersion"`
+	Vdbversion    string `json:"vdbVersion"`
+	Sruversion    string `json:"sruVersion"`
+	Lspversion    string `json:"lspVersion"`
+	Geoversion    string `json:"geoVersion"`
+}
+
+type ServerVersionResponse struct {
+	Items []ServerVersion `json:"items"`
+}
+
+// UnmarshalJSON ignores the unknown fields even with strict decoding, the version checks of the resources must not
+// fail on the fields added by newer FMC versions
+func (r *ServerVersionResponse) UnmarshalJSON(data []byte) error {
+	type serverVersionResponse ServerVersionResponse
+	return json.Unmarshal(data, (*serverVersionResponse)(r))
+}
+
+// e.g. "7.2.0 (build 82)"
+var server_version_regexp = regexp.MustCompile(`^\s*([0-9.]+)(?:\s*\(build\s*([0-9]+)\))?`)
+
+// Version returns the version and the build of FMC, e.g. "7.2.0" and "82"
+func (s *ServerVersion) Version() (string, string) {
+	match := server_version_regexp.FindStringSubmatch(s.Serverversion)
+	if match == nil {
+		return s.Serverversion, ""
+	}
+	return match[1], match[2]
+}
+
+// The server version is part of the platform API, it does not depend on the domain
+func (v *Client) GetFmcServerVersion(ctx context.Context) (*ServerVersion, error) {
+	url := fmt.Sprintf("https://%s/api/fmc_platform/v1/info/serverversion", v.host)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting server version: %s - %s", url, err.Error())
+	}
+	res := &ServerVersionResponse{}
+	err = v.DoRequest(req, res, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting server version: %s - %s", url, err.Error())
+	}
+	if len(res.Items) == 0 {
+		return nil, fmt.Errorf("getting server version: %s - no server version returned", url)
+	}
+	return &res.Items[0], nil
+}
+
+// compareFmcVersions compares the versions, e.g. "7.1" and "6.7.0.3", returns -1, 0 or 1 if a is lower, equal to or
+// higher than b. Missing components count as 0, so "7.1" equals "7.1.0".
+func compareFmcVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		if an < bn {
+			return -1
+		}
+		if an > bn {
+			return 1
+		}
+	}
+	return 0
+}
+
+// requireFmcVersion returns an error if the FMC is older than the minimum version of the feature, so that it fails
+// with the version instead of the 404 of the missing endpoint. The version is read once per provider.
+func (v *Client) requireFmcVersion(ctx context.Context, feature, minimum string) error {
+	v.serverVersionMutex.Lock()
+	defer v.serverVersionMutex.Unlock()
+	if v.serverVersion == nil {
+		serverVersion, err := v.GetFmcServerVersion(ctx)
+		if err != nil {
+			return err
+		}
+		v.serverVersion = serverVersion
+	}
+	version, _ := v.serverVersion.Version()
+	// Unknown versions are not rejected, FMC has the final say
+	if !server_version_regexp.MatchString(version) {
+		return nil
+	}
+	if compareFmcVersions(version, minimum) < 0 {
+		return fmt.Errorf("%s is unsupported on FMC %s, it requires FMC %s or later", feature, version, minimum)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestServerVersion(t *testing.T) {
+	for serverVersion, expected := range map[string][2]string{
+		"7.2.0 (build 82)":   {"7.2.0", "82"},
+		"6.7.0.3(build 105)": {"6.7.0.3", "105"},
+		"7.4.1":              {"7.4.1", ""},
+		"unknown":            {"unknown", ""},
+	} {
+		version, build := (&ServerVersion{Serverversion: serverVersion}).Version()
+		if version != expected[0] || build != expected[1] {
+			t.Errorf("%s: expected %v, got %s %s", serverVersion, expected, version, build)
+		}
+	}
+}
+
+func TestCompareFmcVersions(t *testing.T) {
+	for _, test := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"7.1", "7.1.0", 0},
+		{"6.7.0.3", "7.1", -1},
+		{"7.10.0", "7.2", 1},
+		{"7.0.5", "7.1", -1},
+	} {
+		if result := compareFmcVersions(test.a, test.b); result != test.expected {
+			t.Errorf("%s %s: expected %d, got %d", test.a, test.b, test.expected, result)
+		}
+	}
+}
+
+func TestRequireFmcVersion(t *testing.T) {
+	requests := 0
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items": [{"serverVersion": "6.7.0.3 (build 105)"}]}`))
+	})
+
+	err := c.requireFmcVersion(context.Background(), "fmc_device_policy_based_routes", "7.1")
+	if err == nil || !strings.Contains(err.Error(), "unsupported on FMC 6.7.0.3") {
+		t.Errorf("expected an unsupported version error, got %v", err)
+	}
+	if err := c.requireFmcVersion(context.Background(), "fmc_staged_changes", "6.7"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected the version to be read once, got %d requests", requests)
+	}
+}
+
+func TestDoRequestMissingEndpoint(t *testing.T) {
+	c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<html><body>Not Found</body></html>`))
+	})
+
+	req, err := http.NewRequestWithContext(context.Background(), "GET", server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.DoRequest(req, nil, http.StatusOK)
+	if err == nil || !strings.Contains(err.Error(), "unsupported on this FMC version") || isNotFound(err) {
+		t.Errorf("expected a missing endpoint error, got %v", err)
+	}
+}
+
+func TestResourcesRequireFmcVersion(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.HasSuffix(r.URL.Path, "/serverversion") {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"items": [{"serverVersion": "6.3.0 (build 83)"}]}`))
+	})
+
+	for _, tc := range []struct {
+		name     string
+		resource *schema.Resource
+		config   map[string]interface{}
+		minimum  string
+	}{
+		{"fmc_vni_interfaces", resourceFmcVNIInterfaces(), map[string]interface{}{"device": "1"}, vxlan_minimum_version},
+		{"fmc_vtep_policies", resourceFmcVTEPPolicies(), map[string]interface{}{"device": "1"}, vxlan_minimum_version},
+		{"fmc_device_policy_based_routes", resourceFmcDevicePolicyBasedRoutes(), map[string]interface{}{"device": "1"}, policy_based_route_minimum_version},
+		{"fmc_troubleshoot_files", resourceFmcTroubleshootFiles(), map[string]interface{}{"device": "1"}, troubleshoot_files_minimum_version},
+		{"fmc_bulk_objects", resourceFmcBulkObjects(), map[string]interface{}{"type": "Host", "objects": []interface{}{map[string]interface{}{"name": "h1", "value": "10.0.0.1"}}}, bulk_object_minimum_version},
+	} {
+		_, err := tc.resource.SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(tc.config), c)
+		expected := fmt.Sprintf("%s is unsupported on FMC 6.3.0, it requires FMC %s or later", tc.name, tc.minimum)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%s: expected %q, got %v", tc.name, expected, err)
+		}
+	}
+
+	d := dataSourceFmcDeviceCommands().TestResourceData()
+	_ = d.Set("device", "1")
+	_ = d.Set("command", "show version")
+	diags := dataSourceFmcDeviceCommandsRead(context.Background(), d, c)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "fmc_device_commands is unsupported on FMC 6.3.0, it requires FMC 7.1 or later") {
+		t.Errorf("fmc_device_commands: expected an unsupported version error, got %v", diags)
+	}
+}
@@ -33,7 +33,7 @@ type SyslogAlert struct {
 }
 
 func (v *Client) GetFmcSyslogAlertByName(ctx context.Context, name string) (*SyslogAlert, error) {
-	url := fmt.Sprintf("%s/policy/syslogalerts", v.domainBaseURL)
+	url := v.buildURL("policy/syslogalerts", NewQuery().Limit(fmc_query_limit))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting syslog alert by name: %s - %s", url, err.Error())
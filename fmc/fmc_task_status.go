@@ -0,0 +1,29 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type TaskStatusResponse struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Tasktype string `json:"taskType"`
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+}
+
+func (v *Client) GetFmcTaskStatus(ctx context.Context, id string) (*TaskStatusResponse, error) {
+	url := fmt.Sprintf("%s/job/taskstatuses/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting task status: %s - %s", url, err.Error())
+	}
+	item := &TaskStatusResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting task status: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
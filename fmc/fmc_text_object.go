@@ -0,0 +1,87 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type TextObject struct {
+	ID           string   `json:"id,omitempty"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Description  string   `json:"description"`
+	Variabletype string   `json:"variableType"`
+	Values       []string `json:"values"`
+}
+
+type TextObjectResponse struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Description  string   `json:"description"`
+	Variabletype string   `json:"variableType"`
+	Values       []string `json:"values"`
+}
+
+func (v *Client) CreateFmcTextObject(ctx context.Context, object *TextObject) (*TextObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/textobjects", v.domainBaseURL)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating text objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating text objects: %s - %s", url, err.Error())
+	}
+	item := &TextObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating text objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcTextObject(ctx context.Context, id string) (*TextObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/textobjects/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting text objects: %s - %s", url, err.Error())
+	}
+	item := &TextObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting text objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcTextObject(ctx context.Context, id string, object *TextObject) (*TextObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/textobjects/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating text objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating text objects: %s - %s", url, err.Error())
+	}
+	item := &TextObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating text objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcTextObject(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/object/textobjects/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting text objects: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
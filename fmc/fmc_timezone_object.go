@@ -0,0 +1,85 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type TimezoneObject struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Timezoneid  string `json:"timeZoneId"`
+}
+
+type TimezoneObjectResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Timezoneid  string `json:"timeZoneId"`
+}
+
+func (v *Client) CreateFmcTimezoneObject(ctx context.Context, object *TimezoneObject) (*TimezoneObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/timezoneobjects", v.domainBaseURL)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating timezone objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating timezone objects: %s - %s", url, err.Error())
+	}
+	item := &TimezoneObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating timezone objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcTimezoneObject(ctx context.Context, id string) (*TimezoneObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/timezoneobjects/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting timezone objects: %s - %s", url, err.Error())
+	}
+	item := &TimezoneObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting timezone objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcTimezoneObject(ctx context.Context, id string, object *TimezoneObject) (*TimezoneObjectResponse, error) {
+	url := fmt.Sprintf("%s/object/timezoneobjects/%s", v.domainBaseURL, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating timezone objects: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating timezone objects: %s - %s", url, err.Error())
+	}
+	item := &TimezoneObjectResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating timezone objects: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcTimezoneObject(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/object/timezoneobjects/%s", v.domainBaseURL, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting timezone objects: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
@@ -0,0 +1,65 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type TroubleshootFilesDevice struct {
+	ID string `json:"id"`
+}
+
+type TroubleshootFiles struct {
+	Troubleshootlevel string                    `json:"troubleshootLevel"`
+	Devicelist        []TroubleshootFilesDevice `json:"deviceList"`
+}
+
+type TroubleshootFilesResponse struct {
+	Metadata struct {
+		Task struct {
+			ID string `json:"id"`
+		} `json:"task"`
+	} `json:"metadata"`
+}
+
+// The troubleshoot API (FMC 7.1+) is not part of the config API
+func (v *Client) troubleshootBaseURL() string {
+	return strings.Replace(v.domainBaseURL, "/fmc_config/", "/fmc_troubleshoot/", 1)
+}
+
+func (v *Client) CreateFmcTroubleshootFiles(ctx context.Context, object *TroubleshootFiles) (*TroubleshootFilesResponse, error) {
+	url := fmt.Sprintf("%s/troubleshoot/generatetroubleshootfiles", v.troubleshootBaseURL())
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("generating troubleshoot files: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("generating troubleshoot files: %s - %s", url, err.Error())
+	}
+	item := &TroubleshootFilesResponse{}
+	err = v.DoRequest(req, item, http.StatusAccepted)
+	if err != nil {
+		return nil, fmt.Errorf("generating troubleshoot files: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+// The troubleshoot files are downloaded as an archive named after the task which generated them
+func (v *Client) DownloadFmcTroubleshootFiles(ctx context.Context, taskID string, w io.Writer) error {
+	url := fmt.Sprintf("%s/troubleshoot/downloadtroubleshootfiles/%s", v.troubleshootBaseURL(), taskID)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("downloading troubleshoot files: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, w, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("downloading troubleshoot files: %s - %s", url, err.Error())
+	}
+	return nil
+}
@@ -43,8 +43,8 @@ type URLObjectsResponse struct {
 	} `json:"items"`
 }
 
-func (v *Client) GetFmcURLObjectByNameOrValue(ctx context.Context, nameOrValue string) (*URLObjectResponse, error) {
-	url := fmt.Sprintf("%s/object/urls?expanded=false&filter=nameOrValue:%s", v.domainBaseURL, nameOrValue)
+func (v *Client) GetFmcURLObjectByNameOrValue(ctx context.Context, nameOrValue string, filters map[string]string) (*URLObjectResponse, error) {
+	url := v.buildURL("object/urls", NewQuery().Expanded(false).Filter("nameOrValue", nameOrValue).Filters(filters))
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting url object by name/value: %s - %s", url, err.Error())
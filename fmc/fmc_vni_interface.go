@@ -0,0 +1,138 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type VNIInterfaceSubConfig struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type VNIInterfaceIPv4Static struct {
+	Address string `json:"address"`
+	Netmask string `json:"netmask"`
+}
+
+type VNIInterfaceIPv4 struct {
+	Static *VNIInterfaceIPv4Static `json:"static,omitempty"`
+}
+
+// IPv6 addresses are a list, unlike the IPv4 address
+type VNIInterfaceIPv6Address struct {
+	Address string `json:"address"`
+	Prefix  string `json:"prefix"`
+}
+
+type VNIInterfaceIPv6 struct {
+	Enableipv6 bool                      `json:"enableIPV6"`
+	Addresses  []VNIInterfaceIPv6Address `json:"addresses,omitempty"`
+}
+
+type VNIInterface struct {
+	ID                    string                 `json:"id,omitempty"`
+	Type                  string                 `json:"type"`
+	Name                  string                 `json:"name,omitempty"`
+	Ifname                string                 `json:"ifname,omitempty"`
+	Description           string                 `json:"description,omitempty"`
+	Enabled               bool                   `json:"enabled"`
+	Vniid                 int                    `json:"vniId"`
+	Vtepid                int                    `json:"vtepID"`
+	Segmentid             int                    `json:"segmentId,omitempty"`
+	Multicastgroupaddress string                 `json:"multicastGroupAddress,omitempty"`
+	Enableproxy           bool                   `json:"enableProxy"`
+	Securityzone          *VNIInterfaceSubConfig `json:"securityZone,omitempty"`
+	Ipv4                  *VNIInterfaceIPv4      `json:"ipv4,omitempty"`
+	Ipv6                  *VNIInterfaceIPv6      `json:"ipv6,omitempty"`
+}
+
+type VNIInterfaceResponse struct {
+	ID                    string `json:"id"`
+	Type                  string `json:"type"`
+	Name                  string `json:"name"`
+	Ifname                string `json:"ifname"`
+	Description           string `json:"description"`
+	Enabled               bool   `json:"enabled"`
+	Vniid                 int    `json:"vniId"`
+	Vtepid                int    `json:"vtepID"`
+	Segmentid             int    `json:"segmentId"`
+	Multicastgroupaddress string `json:"multicastGroupAddress"`
+	Enableproxy           bool   `json:"enableProxy"`
+	Securityzone          struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"securityZone"`
+	Ipv4 struct {
+		Static struct {
+			Address string `json:"address"`
+			Netmask string `json:"netmask"`
+		} `json:"static"`
+	} `json:"ipv4"`
+	Ipv6 struct {
+		Addresses []VNIInterfaceIPv6Address `json:"addresses"`
+	} `json:"ipv6"`
+}
+
+func (v *Client) CreateFmcVNIInterface(ctx context.Context, deviceID string, object *VNIInterface) (*VNIInterfaceResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vniinterfaces", v.domainBaseURL, deviceID)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating vni interfaces: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating vni interfaces: %s - %s", url, err.Error())
+	}
+	item := &VNIInterfaceResponse{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating vni interfaces: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcVNIInterface(ctx context.Context, deviceID, id string) (*VNIInterfaceResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vniinterfaces/%s", v.domainBaseURL, deviceID, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting vni interfaces: %s - %s", url, err.Error())
+	}
+	item := &VNIInterfaceResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting vni interfaces: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcVNIInterface(ctx context.Context, deviceID, id string, object *VNIInterface) (*VNIInterfaceResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vniinterfaces/%s", v.domainBaseURL, deviceID, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating vni interfaces: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating vni interfaces: %s - %s", url, err.Error())
+	}
+	item := &VNIInterfaceResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating vni interfaces: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcVNIInterface(ctx context.Context, deviceID, id string) error {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vniinterfaces/%s", v.domainBaseURL, deviceID, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting vni interfaces: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
@@ -0,0 +1,111 @@
+package fmc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type VTEPPolicySubConfig struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type VTEPEntry struct {
+	Sourceinterface          VTEPPolicySubConfig  `json:"sourceInterface"`
+	Nvevtepid                int                  `json:"nveVtepId"`
+	Nvedestinationport       int                  `json:"nveDestinationPort"`
+	Nveencapsulationtype     string               `json:"nveEncapsulationType"`
+	Nveneighbordiscoverytype string               `json:"nveNeighborDiscoveryType,omitempty"`
+	Nveneighboraddress       *VTEPPolicySubConfig `json:"nveNeighborAddress,omitempty"`
+}
+
+type VTEPPolicy struct {
+	ID          string      `json:"id,omitempty"`
+	Type        string      `json:"type"`
+	Nveenable   bool        `json:"nveEnable"`
+	Vtepentries []VTEPEntry `json:"vtepEntries"`
+}
+
+type VTEPPolicyResponse struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Nveenable   bool   `json:"nveEnable"`
+	Vtepentries []struct {
+		Sourceinterface struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+			Name string `json:"name"`
+		} `json:"sourceInterface"`
+		Nvevtepid                int    `json:"nveVtepId"`
+		Nvedestinationport       int    `json:"nveDestinationPort"`
+		Nveencapsulationtype     string `json:"nveEncapsulationType"`
+		Nveneighbordiscoverytype string `json:"nveNeighborDiscoveryType"`
+		Nveneighboraddress       struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"nveNeighborAddress"`
+	} `json:"vtepEntries"`
+}
+
+func (v *Client) CreateFmcVTEPPolicy(ctx context.Context, deviceID string, object *VTEPPolicy) (*VTEPPolicyResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vteppolicies", v.domainBaseURL, deviceID)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("creating vtep policies: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating vtep policies: %s - %s", url, err.Error())
+	}
+	item := &VTEPPolicyResponse{}
+	err = v.DoRequest(req, item, http.StatusCreated)
+	if err != nil {
+		return nil, fmt.Errorf("creating vtep policies: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) GetFmcVTEPPolicy(ctx context.Context, deviceID, id string) (*VTEPPolicyResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vteppolicies/%s", v.domainBaseURL, deviceID, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting vtep policies: %s - %s", url, err.Error())
+	}
+	item := &VTEPPolicyResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("getting vtep policies: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) UpdateFmcVTEPPolicy(ctx context.Context, deviceID, id string, object *VTEPPolicy) (*VTEPPolicyResponse, error) {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vteppolicies/%s", v.domainBaseURL, deviceID, id)
+	body, err := json.Marshal(&object)
+	if err != nil {
+		return nil, fmt.Errorf("updating vtep policies: %s - %s", url, err.Error())
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("updating vtep policies: %s - %s", url, err.Error())
+	}
+	item := &VTEPPolicyResponse{}
+	err = v.DoRequest(req, item, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("updating vtep policies: %s - %s", url, err.Error())
+	}
+	return item, nil
+}
+
+func (v *Client) DeleteFmcVTEPPolicy(ctx context.Context, deviceID, id string) error {
+	url := fmt.Sprintf("%s/devices/devicerecords/%s/vteppolicies/%s", v.domainBaseURL, deviceID, id)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("deleting vtep policies: %s - %s", url, err.Error())
+	}
+	err = v.DoRequest(req, nil, http.StatusOK)
+	return err
+}
@@ -3,6 +3,7 @@ package fmc
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,6 +13,38 @@ import (
 var access_policy_type string = "AccessPolicy"
 var access_policy_default_action_type string = "AccessPolicyDefaultAction"
 var access_policy_default_syslog_alert_type string = "SyslogAlert"
+var access_policy_inheritance_setting_type string = "AccessPolicyInheritanceSetting"
+var access_policy_advanced_setting_type string = "AdvancedSettings"
+var identity_policy_type string = "IdentityPolicy"
+var access_policy_security_intelligence_type string = "SecurityIntelligencePolicy"
+var dns_policy_type string = "DNSPolicy"
+
+// Default actions which do not support the settings, FMC only rejects these combinations when the policy is saved
+var access_policy_default_action_unsupported = map[string][]string{
+	"default_action_base_intrusion_policy_id": {"BLOCK", "TRUST", "NETWORK_DISCOVERY", "INHERIT_FROM_PARENT"},
+	"default_action_enable_syslog":            {"NETWORK_DISCOVERY"},
+	"default_action_syslog_config_id":         {"NETWORK_DISCOVERY"},
+}
+
+// validateAccessPolicyDefaultAction returns an error if the default action does not support one of the settings which are set
+func validateAccessPolicyDefaultAction(action string, settings map[string]bool) error {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !settings[key] {
+			continue
+		}
+		for _, unsupported := range access_policy_default_action_unsupported[key] {
+			if strings.EqualFold(action, unsupported) {
+				return fmt.Errorf("%s cannot be set if default_action is %s", key, unsupported)
+			}
+		}
+	}
+	return nil
+}
 
 func resourceFmcAccessPolicies() *schema.Resource {
 	return &schema.Resource{
@@ -20,7 +53,7 @@ func resourceFmcAccessPolicies() *schema.Resource {
 			"## Example\n" +
 			"An example is shown below: \n" +
 			"```hcl\n" +
-			"resource \"fmc_access_policies\" \"access_policy\" {\n" +
+			"resource \"fmc_access_policy\" \"access_policy\" {\n" +
 			"    name = \"Terraform Access Policy\"\n" +
 			"    # default_action = \"block\" # Cannot have block with base IPS policy\n" +
 			"    default_action = \"permit\"\n" +
@@ -28,17 +61,93 @@ func resourceFmcAccessPolicies() *schema.Resource {
 			"    default_action_send_events_to_fmc = \"true\"\n" +
 			"    default_action_log_end = \"true\"\n" +
 			"    default_action_syslog_config_id = data.fmc_syslog_alerts.syslog_alert.id\n" +
+			"    default_action_enable_syslog = true\n" +
+			"    default_action_syslog_severity = \"warning\"\n" +
+			"    prefilter_policy_id = fmc_prefilter_policy.prefilter_policy.id\n" +
+			"    advanced {\n" +
+			"        tls_server_identity_discovery = true\n" +
+			"        interactive_block_bypass_timeout = 300\n" +
+			"    }\n" +
+			"    security_intelligence {\n" +
+			"        network_block_list {\n" +
+			"            id = data.fmc_network_objects.blocked.id\n" +
+			"            type = data.fmc_network_objects.blocked.type\n" +
+			"        }\n" +
+			"        network_logging = true\n" +
+			"        dns_policy_id = data.fmc_dns_policies.default.id\n" +
+			"    }\n" +
+			"}\n" +
+			"\n" +
+			"resource \"fmc_access_policy\" \"child_access_policy\" {\n" +
+			"    name = \"Terraform Child Access Policy\"\n" +
+			"    default_action = \"inherit_from_parent\"\n" +
+			"    base_policy_id = fmc_access_policy.access_policy.id\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** `base_policy_id` is required if the default action is `INHERIT_FROM_PARENT`. " +
+			"`default_action_base_intrusion_policy_id` can only be set with `PERMIT`, and the syslog settings cannot be set with `NETWORK_DISCOVERY`, both are checked during plan.\n" +
+			"\n" +
+			"**Note** All the attributes are updated in place, so the rules of the policy are kept when e.g. the description or the logging of the default action change.\n" +
+			"\n" +
+			"**Note** Existing policies, e.g. created in the FMC UI, can be imported by ID, e.g. `terraform import fmc_access_policy.access_policy <uuid>`. " +
+			"The import also reads `base_policy_id` and the `advanced` and `security_intelligence` settings, add the blocks to the configuration to keep managing them.\n" +
+			"\n" +
+			"**Note** Set `validate_references` to check during plan that `default_action_syslog_config_id` refers to a syslog alert, which otherwise only fails during deployment.\n" +
+			"\n" +
+			"**Note** With `default_action_enable_syslog`, the events of the default action are sent to the syslog servers of the logging settings of the policy, " +
+			"or of `default_action_syslog_config_id` if set, which overrides them.\n" +
+			"\n" +
+			"**Note** The `advanced` settings are only read and updated if the block is set, the settings left out of the block keep their values in FMC.\n" +
+			"\n" +
+			"**Note** The `security_intelligence` settings are also only read and updated if the block is set. The block and do-not-block lists " +
+			"of the block replace the lists in FMC, objects on a do-not-block list are never blocked by Security Intelligence, even if on a block list or in a feed.\n" +
+			"\n" +
+			"**Note** This resource was previously named `fmc_access_policies`, which still works but is deprecated. " +
+			"Migrate by removing the old resource from the state and importing it into `fmc_access_policy` with the same ID.",
 		CreateContext: resourceFmcAccessPoliciesCreate,
 		ReadContext:   resourceFmcAccessPoliciesRead,
 		UpdateContext: resourceFmcAccessPoliciesUpdate,
 		DeleteContext: resourceFmcAccessPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFmcAccessPoliciesImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if strings.EqualFold(d.Get("default_action").(string), "INHERIT_FROM_PARENT") && d.NewValueKnown("base_policy_id") && d.Get("base_policy_id").(string) == "" {
+				return fmt.Errorf("base_policy_id is required if default_action is INHERIT_FROM_PARENT")
+			}
+			// Unknown IDs, e.g. of objects created in the same apply, are set as well
+			if err := validateAccessPolicyDefaultAction(d.Get("default_action").(string), map[string]bool{
+				"default_action_base_intrusion_policy_id": !d.NewValueKnown("default_action_base_intrusion_policy_id") || d.Get("default_action_base_intrusion_policy_id").(string) != "",
+				"default_action_enable_syslog":            d.Get("default_action_enable_syslog").(bool),
+				"default_action_syslog_config_id":         !d.NewValueKnown("default_action_syslog_config_id") || d.Get("default_action_syslog_config_id").(string) != "",
+			}); err != nil {
+				return err
+			}
+			if !d.Get("validate_references").(bool) {
+				return nil
+			}
+			// The IDs are looked up with the type FMC expects, so an ID of another type of object is reported as well
+			c := m.(*Client)
+			for key, objectType := range map[string]string{
+				"default_action_syslog_config_id":         access_policy_default_syslog_alert_type,
+				"default_action_base_intrusion_policy_id": "IntrusionPolicy",
+			} {
+				id := d.Get(key).(string)
+				if id == "" || !d.NewValueKnown(key) {
+					continue
+				}
+				if err := c.CheckFmcObjectReference(ctx, objectType, id); err != nil {
+					return fmt.Errorf("%s %s is not a %s in FMC: %s", key, id, objectType, err.Error())
+				}
+			}
+			return nil
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_policy_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -52,13 +161,7 @@ func resourceFmcAccessPolicies() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -68,7 +171,7 @@ func resourceFmcAccessPolicies() *schema.Resource {
 			"default_action_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The ID of default action of this resource",
+				Description: "The ID of the default action of this resource, e.g. for syslog overrides of the default action",
 			},
 			"default_action": {
 				Type:     schema.TypeString,
@@ -112,17 +215,142 @@ func resourceFmcAccessPolicies() *schema.Resource {
 			"default_action_syslog_config_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Syslog configuration ID for this resource",
+				Description: "Syslog configuration ID for this resource, overriding the syslog servers of the logging settings of the policy",
+			},
+			"default_action_enable_syslog": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable sending the events of the default action to the syslog servers for this resource",
+			},
+			"default_action_syslog_severity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"ALERT", "CRIT", "DEBUG", "EMERG", "ERR", "INFO", "NOTICE", "WARNING"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				Description: `Severity of the syslog messages of the default action for this resource, "ALERT", "CRIT", "DEBUG", "EMERG", "ERR", "INFO", "NOTICE" or "WARNING"`,
+			},
+			"base_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the parent access policy this resource inherits from",
+			},
+			"prefilter_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the prefilter policy of this resource, FMC assigns the default prefilter policy if not set",
 			},
 			"default_action_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The type of default action of this resource",
 			},
+			"advanced": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tls_server_identity_discovery": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Discover the identity of TLS 1.3 servers before the rules are evaluated, so that rules on applications and URLs match their encrypted traffic",
+						},
+						"interactive_block_bypass_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								if v := val.(int); v < 0 || v > 31536000 {
+									errs = append(errs, fmt.Errorf("%q must be between 0 and 31536000 seconds, got: %d", key, v))
+								}
+								return
+							},
+							Description: "How long in seconds users can access a site after bypassing an interactive block, 600 by default in FMC",
+						},
+						"inspect_traffic_during_apply": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Inspect the traffic while the policy is deployed, otherwise it passes uninspected while snort restarts",
+						},
+						"identity_policy_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the identity policy associated with this resource, none if not set",
+						},
+					},
+				},
+				Description: "Advanced settings of this resource",
+			},
+			"security_intelligence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_block_list":        accessPolicySecurityIntelligenceObjectsSchema("The networks, network feeds and lists blocked by Security Intelligence"),
+						"network_do_not_block_list": accessPolicySecurityIntelligenceObjectsSchema("The networks, network feeds and lists never blocked by Security Intelligence"),
+						"network_logging": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Log the connections blocked by the network block list",
+						},
+						"url_block_list":        accessPolicySecurityIntelligenceObjectsSchema("The URLs, URL feeds and lists blocked by Security Intelligence"),
+						"url_do_not_block_list": accessPolicySecurityIntelligenceObjectsSchema("The URLs, URL feeds and lists never blocked by Security Intelligence"),
+						"url_logging": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Log the connections blocked by the URL block list",
+						},
+						"dns_policy_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The ID of the DNS policy with the DNS block and do-not-block lists, the default DNS policy is used if not set",
+						},
+					},
+				},
+				Description: "Security Intelligence settings of this resource",
+			},
+			"validate_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check during plan that the syslog configuration is a syslog alert and the base intrusion policy is an intrusion policy in FMC",
+			},
 		},
 	}
 }
 
+// expandAccessPolicyPrefilterPolicy returns the prefilter policy of the access policy, nil leaves the one assigned by FMC
+func expandAccessPolicyPrefilterPolicy(d *schema.ResourceData) *AccessPolicySubConfig {
+	id := d.Get("prefilter_policy_id").(string)
+	if id == "" {
+		return nil
+	}
+	return &AccessPolicySubConfig{
+		ID:   id,
+		Type: prefilterPolicyType,
+	}
+}
+
 func resourceFmcAccessPoliciesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 	// Warning or errors can be collected in a slice type
@@ -144,19 +372,31 @@ func resourceFmcAccessPoliciesCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	defaultAction := AccessPolicyDefaultAction{
+		Type:            access_policy_default_action_type,
+		Intrusionpolicy: intrusionPolicy,
+		Syslogconfig:    syslogConfig,
+		Logbegin:        d.Get("default_action_log_begin").(bool),
+		Logend:          d.Get("default_action_log_end").(bool),
+		Sendeventstofmc: d.Get("default_action_send_events_to_fmc").(bool),
+		Action:          strings.ToUpper(d.Get("default_action").(string)),
+		Enablesyslog:    d.Get("default_action_enable_syslog").(bool),
+		Syslogseverity:  strings.ToUpper(d.Get("default_action_syslog_severity").(string)),
+	}
+	inherit := defaultAction.Action == "INHERIT_FROM_PARENT"
+	createDefaultAction := defaultAction
+	if inherit {
+		// The default action can only be inherited once the parent policy is set, so start with a plain one
+		createDefaultAction.Action = "BLOCK"
+		createDefaultAction.Intrusionpolicy = nil
+	}
+
 	res, err := c.CreateFmcAccessPolicy(ctx, &AccessPolicy{
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
-		Defaultaction: AccessPolicyDefaultAction{
-			Type:            access_policy_default_action_type,
-			Intrusionpolicy: intrusionPolicy,
-			Syslogconfig:    syslogConfig,
-			Logbegin:        d.Get("default_action_log_begin").(bool),
-			Logend:          d.Get("default_action_log_end").(bool),
-			Sendeventstofmc: d.Get("default_action_send_events_to_fmc").(bool),
-			Action:          strings.ToUpper(d.Get("default_action").(string)),
-		},
-		Type: access_policy_type,
+		Name:                   d.Get("name").(string),
+		Description:            c.withDescriptionMarker(d),
+		Defaultaction:          createDefaultAction,
+		Prefilterpolicysetting: expandAccessPolicyPrefilterPolicy(d),
+		Type:                   access_policy_type,
 	})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -167,9 +407,90 @@ func resourceFmcAccessPoliciesCreate(ctx context.Context, d *schema.ResourceData
 		return diags
 	}
 	d.SetId(res.ID)
+
+	if basePolicyID := d.Get("base_policy_id").(string); basePolicyID != "" {
+		if err := updateFmcAccessPolicyBasePolicy(ctx, c, res.ID, basePolicyID); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to set base policy of access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	if inherit {
+		defaultAction.ID = res.Defaultaction.ID
+		_, err := c.UpdateFmcAccessPolicy(ctx, res.ID, &AccessPolicy{
+			ID:                     res.ID,
+			Name:                   d.Get("name").(string),
+			Description:            c.withDescriptionMarker(d),
+			Defaultaction:          defaultAction,
+			Prefilterpolicysetting: expandAccessPolicyPrefilterPolicy(d),
+			Type:                   access_policy_type,
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to set inherited default action of access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	if err := updateFmcAccessPolicyAdvancedSetting(ctx, c, d); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update advanced settings of access policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	if err := updateFmcAccessPolicySecurityIntelligence(ctx, c, d); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update security intelligence settings of access policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
 	return resourceFmcAccessPoliciesRead(ctx, d, m)
 }
 
+// resourceFmcAccessPoliciesImport reads the settings which are only refreshed once they are in the state, so that
+// they are imported too
+func resourceFmcAccessPoliciesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*Client)
+	id := d.Id()
+
+	inheritance, err := c.GetFmcAccessPolicyInheritanceSetting(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import access policy %s: %s", id, err.Error())
+	}
+	basePolicyID := ""
+	if inheritance.Baseaccesspolicy != nil {
+		basePolicyID = inheritance.Baseaccesspolicy.ID
+	}
+	advanced, err := c.GetFmcAccessPolicyAdvancedSetting(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import access policy %s: %s", id, err.Error())
+	}
+	securityIntelligence, err := c.GetFmcAccessPolicySecurityIntelligence(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import access policy %s: %s", id, err.Error())
+	}
+
+	for key, value := range map[string]interface{}{
+		"base_policy_id":        basePolicyID,
+		"advanced":              flattenAccessPolicyAdvancedSetting(advanced),
+		"security_intelligence": flattenAccessPolicySecurityIntelligence(securityIntelligence),
+	} {
+		if err := d.Set(key, value); err != nil {
+			return nil, fmt.Errorf("unable to import access policy %s: %s", id, err.Error())
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceFmcAccessPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 
@@ -231,15 +552,289 @@ func resourceFmcAccessPoliciesRead(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
+	// The nested settings of the default action are read as well, so that imported policies are fully populated
+	intrusionPolicyID, syslogConfigID := "", ""
+	if item.Defaultaction.Intrusionpolicy != nil {
+		intrusionPolicyID = item.Defaultaction.Intrusionpolicy.ID
+	}
+	if item.Defaultaction.Syslogconfig != nil {
+		syslogConfigID = item.Defaultaction.Syslogconfig.ID
+	}
+	for key, value := range map[string]interface{}{
+		"default_action_type":                     item.Defaultaction.Type,
+		"default_action_base_intrusion_policy_id": intrusionPolicyID,
+		"default_action_send_events_to_fmc":       item.Defaultaction.Sendeventstofmc,
+		"default_action_log_begin":                item.Defaultaction.Logbegin,
+		"default_action_log_end":                  item.Defaultaction.Logend,
+		"default_action_syslog_config_id":         syslogConfigID,
+		"default_action_enable_syslog":            item.Defaultaction.Enablesyslog,
+		"default_action_syslog_severity":          item.Defaultaction.Syslogseverity,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	prefilterPolicyID := ""
+	if item.Prefilterpolicysetting != nil {
+		prefilterPolicyID = item.Prefilterpolicysetting.ID
+	}
+	if err := d.Set("prefilter_policy_id", prefilterPolicyID); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read access policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if d.Get("base_policy_id").(string) != "" || item.Defaultaction.Action == "INHERIT_FROM_PARENT" {
+		setting, err := c.GetFmcAccessPolicyInheritanceSetting(ctx, id)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access policy inheritance settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		basePolicyID := ""
+		if setting.Baseaccesspolicy != nil {
+			basePolicyID = setting.Baseaccesspolicy.ID
+		}
+		if err := d.Set("base_policy_id", basePolicyID); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	if len(d.Get("advanced").([]interface{})) > 0 {
+		setting, err := c.GetFmcAccessPolicyAdvancedSetting(ctx, id)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access policy advanced settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		if err := d.Set("advanced", flattenAccessPolicyAdvancedSetting(setting)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	if len(d.Get("security_intelligence").([]interface{})) > 0 {
+		setting, err := c.GetFmcAccessPolicySecurityIntelligence(ctx, id)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access policy security intelligence settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		if err := d.Set("security_intelligence", flattenAccessPolicySecurityIntelligence(setting)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
 	return diags
 }
 
+func flattenAccessPolicyAdvancedSetting(setting *AccessPolicyAdvancedSetting) []interface{} {
+	identityPolicyID := ""
+	if setting.Identitypolicysetting != nil {
+		identityPolicyID = setting.Identitypolicysetting.ID
+	}
+	return []interface{}{map[string]interface{}{
+		"tls_server_identity_discovery":    setting.Enabletlsserveridentitydiscovery,
+		"interactive_block_bypass_timeout": setting.Interactiveblockallowtime,
+		"inspect_traffic_during_apply":     setting.Inspecttrafficduringapply,
+		"identity_policy_id":               identityPolicyID,
+	}}
+}
+
+// updateFmcAccessPolicyAdvancedSetting updates the advanced settings of the access policy set in the advanced block,
+// the other settings keep their values in FMC
+func updateFmcAccessPolicyAdvancedSetting(ctx context.Context, c *Client, d *schema.ResourceData) error {
+	advanced := d.Get("advanced").([]interface{})
+	if len(advanced) == 0 || advanced[0] == nil {
+		return nil
+	}
+	setting, err := c.GetFmcAccessPolicyAdvancedSetting(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	setting.ID = d.Id()
+	setting.Type = access_policy_advanced_setting_type
+	expandAccessPolicyAdvancedSetting(d, setting)
+	_, err = c.UpdateFmcAccessPolicyAdvancedSetting(ctx, d.Id(), setting)
+	return err
+}
+
+// expandAccessPolicyAdvancedSetting sets the advanced settings of the advanced block on the settings read from FMC.
+// The settings left out are unknown on create, and hold the values read from FMC afterwards, GetOkExists tells
+// them apart from false or 0.
+func expandAccessPolicyAdvancedSetting(d *schema.ResourceData, setting *AccessPolicyAdvancedSetting) {
+	if val, ok := d.GetOkExists("advanced.0.tls_server_identity_discovery"); ok {
+		setting.Enabletlsserveridentitydiscovery = val.(bool)
+	}
+	if val, ok := d.GetOkExists("advanced.0.interactive_block_bypass_timeout"); ok {
+		setting.Interactiveblockallowtime = val.(int)
+	}
+	if val, ok := d.GetOkExists("advanced.0.inspect_traffic_during_apply"); ok {
+		setting.Inspecttrafficduringapply = val.(bool)
+	}
+	setting.Identitypolicysetting = nil
+	if val, ok := d.GetOk("advanced.0.identity_policy_id"); ok {
+		setting.Identitypolicysetting = &AccessPolicySubConfig{
+			ID:   val.(string),
+			Type: identity_policy_type,
+		}
+	}
+}
+
+func accessPolicySecurityIntelligenceObjectsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the object",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: `The type of the object, e.g. "Network", "SINetworkFeed" or "SIURLList"`,
+				},
+			},
+		},
+		Description: description,
+	}
+}
+
+func flattenAccessPolicySecurityIntelligenceObjects(objects []AccessPolicySubConfig) []interface{} {
+	flattened := make([]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		flattened = append(flattened, map[string]interface{}{
+			"id":   obj.ID,
+			"type": obj.Type,
+		})
+	}
+	return flattened
+}
+
+func expandAccessPolicySecurityIntelligenceObjects(objects *schema.Set) []AccessPolicySubConfig {
+	expanded := []AccessPolicySubConfig{}
+	for _, obj := range objects.List() {
+		obji := obj.(map[string]interface{})
+		expanded = append(expanded, AccessPolicySubConfig{
+			ID:   obji["id"].(string),
+			Type: obji["type"].(string),
+		})
+	}
+	return expanded
+}
+
+func flattenAccessPolicySecurityIntelligence(setting *AccessPolicySecurityIntelligence) []interface{} {
+	dnsPolicyID := ""
+	if setting.Dnspolicy != nil {
+		dnsPolicyID = setting.Dnspolicy.ID
+	}
+	return []interface{}{map[string]interface{}{
+		"network_block_list":        flattenAccessPolicySecurityIntelligenceObjects(setting.Networks.Blocklist),
+		"network_do_not_block_list": flattenAccessPolicySecurityIntelligenceObjects(setting.Networks.Donotblocklist),
+		"network_logging":           setting.Networks.Logging,
+		"url_block_list":            flattenAccessPolicySecurityIntelligenceObjects(setting.Urls.Blocklist),
+		"url_do_not_block_list":     flattenAccessPolicySecurityIntelligenceObjects(setting.Urls.Donotblocklist),
+		"url_logging":               setting.Urls.Logging,
+		"dns_policy_id":             dnsPolicyID,
+	}}
+}
+
+// updateFmcAccessPolicySecurityIntelligence updates the security intelligence settings of the access policy set in the
+// security_intelligence block, the lists are replaced and the other settings keep their values in FMC
+func updateFmcAccessPolicySecurityIntelligence(ctx context.Context, c *Client, d *schema.ResourceData) error {
+	si := d.Get("security_intelligence").([]interface{})
+	if len(si) == 0 || si[0] == nil {
+		return nil
+	}
+	setting, err := c.GetFmcAccessPolicySecurityIntelligence(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	setting.ID = d.Id()
+	setting.Type = access_policy_security_intelligence_type
+	expandAccessPolicySecurityIntelligence(d, setting)
+	_, err = c.UpdateFmcAccessPolicySecurityIntelligence(ctx, d.Id(), setting)
+	return err
+}
+
+// expandAccessPolicySecurityIntelligence sets the security intelligence settings of the security_intelligence block
+// on the settings read from FMC, like expandAccessPolicyAdvancedSetting
+func expandAccessPolicySecurityIntelligence(d *schema.ResourceData, setting *AccessPolicySecurityIntelligence) {
+	setting.Networks.Blocklist = expandAccessPolicySecurityIntelligenceObjects(d.Get("security_intelligence.0.network_block_list").(*schema.Set))
+	setting.Networks.Donotblocklist = expandAccessPolicySecurityIntelligenceObjects(d.Get("security_intelligence.0.network_do_not_block_list").(*schema.Set))
+	if val, ok := d.GetOkExists("security_intelligence.0.network_logging"); ok {
+		setting.Networks.Logging = val.(bool)
+	}
+	setting.Urls.Blocklist = expandAccessPolicySecurityIntelligenceObjects(d.Get("security_intelligence.0.url_block_list").(*schema.Set))
+	setting.Urls.Donotblocklist = expandAccessPolicySecurityIntelligenceObjects(d.Get("security_intelligence.0.url_do_not_block_list").(*schema.Set))
+	if val, ok := d.GetOkExists("security_intelligence.0.url_logging"); ok {
+		setting.Urls.Logging = val.(bool)
+	}
+	if val, ok := d.GetOk("security_intelligence.0.dns_policy_id"); ok {
+		setting.Dnspolicy = &AccessPolicySubConfig{
+			ID:   val.(string),
+			Type: dns_policy_type,
+		}
+	}
+}
+
+// updateFmcAccessPolicyBasePolicy sets the parent policy of the access policy, or removes it if basePolicyID is empty
+func updateFmcAccessPolicyBasePolicy(ctx context.Context, c *Client, id, basePolicyID string) error {
+	setting := &AccessPolicyInheritanceSetting{
+		ID:   id,
+		Type: access_policy_inheritance_setting_type,
+	}
+	if basePolicyID != "" {
+		setting.Baseaccesspolicy = &AccessPolicySubConfig{
+			ID:   basePolicyID,
+			Type: access_policy_type,
+		}
+	}
+	_, err := c.UpdateFmcAccessPolicyInheritanceSetting(ctx, id, setting)
+	return err
+}
+
 func resourceFmcAccessPoliciesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 	// Warning or errors can be collected in a slice type
 	// var diags diag.Diagnostics
 	var diags diag.Diagnostics
-	if d.HasChanges("name", "description", "type", "default_action", "default_action_base_intrusion_policy_id", "default_action_send_events_to_fmc", "default_action_log_begin", "default_action_log_end", "default_action_syslog_config_id", "default_action_type") {
+	if d.HasChanges("name", "description", "type", "default_action", "default_action_base_intrusion_policy_id", "default_action_send_events_to_fmc", "default_action_log_begin", "default_action_log_end", "default_action_syslog_config_id", "default_action_enable_syslog", "default_action_syslog_severity", "default_action_type", "base_policy_id", "prefilter_policy_id") {
 		var intrusionPolicy, syslogConfig *AccessPolicySubConfig
 		if val, ok := d.GetOk("default_action_base_intrusion_policy_id"); ok {
 			intrusionPolicy = &AccessPolicySubConfig{
@@ -254,10 +849,22 @@ func resourceFmcAccessPoliciesUpdate(ctx context.Context, d *schema.ResourceData
 				Type: access_policy_default_syslog_alert_type,
 			}
 		}
+		// The parent policy has to be set before the default action can be inherited from it, and removed only after
+		basePolicyID := d.Get("base_policy_id").(string)
+		if d.HasChange("base_policy_id") && basePolicyID != "" {
+			if err := updateFmcAccessPolicyBasePolicy(ctx, c, d.Id(), basePolicyID); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "unable to set base policy of access policy",
+					Detail:   err.Error(),
+				})
+				return diags
+			}
+		}
 		res, err := c.UpdateFmcAccessPolicy(ctx, d.Id(), &AccessPolicy{
 			ID:          d.Id(),
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Defaultaction: AccessPolicyDefaultAction{
 				ID:              d.Get("default_action_id").(string),
 				Type:            access_policy_default_action_type,
@@ -267,18 +874,51 @@ func resourceFmcAccessPoliciesUpdate(ctx context.Context, d *schema.ResourceData
 				Logend:          d.Get("default_action_log_end").(bool),
 				Sendeventstofmc: d.Get("default_action_send_events_to_fmc").(bool),
 				Action:          strings.ToUpper(d.Get("default_action").(string)),
+				Enablesyslog:    d.Get("default_action_enable_syslog").(bool),
+				Syslogseverity:  strings.ToUpper(d.Get("default_action_syslog_severity").(string)),
 			},
-			Type: access_policy_type,
+			Prefilterpolicysetting: expandAccessPolicyPrefilterPolicy(d),
+			Type:                   access_policy_type,
 		})
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "unable to create access policy",
+				Summary:  "unable to update access policy",
 				Detail:   err.Error(),
 			})
 			return diags
 		}
 		d.SetId(res.ID)
+		if d.HasChange("base_policy_id") && basePolicyID == "" {
+			if err := updateFmcAccessPolicyBasePolicy(ctx, c, d.Id(), ""); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "unable to remove base policy of access policy",
+					Detail:   err.Error(),
+				})
+				return diags
+			}
+		}
+	}
+	if d.HasChange("advanced") {
+		if err := updateFmcAccessPolicyAdvancedSetting(ctx, c, d); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update advanced settings of access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	if d.HasChange("security_intelligence") {
+		if err := updateFmcAccessPolicySecurityIntelligence(ctx, c, d); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update security intelligence settings of access policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
 	}
 	return resourceFmcAccessPoliciesRead(ctx, d, m)
 }
@@ -292,7 +932,9 @@ func resourceFmcAccessPoliciesDelete(ctx context.Context, d *schema.ResourceData
 	id := d.Id()
 
 	err := c.DeleteFmcAccessPolicy(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("access policy", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete access policy",
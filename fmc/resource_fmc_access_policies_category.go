@@ -24,10 +24,11 @@ func resourceFmcAccessPoliciesCategory() *schema.Resource {
 		DeleteContext: resourceFmcAccessPoliciesCategoryDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The name of this category",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFmcName(fmc_policy_name_max_length),
+				Description:  "The name of this category",
 			},
 			"access_policy_id": {
 				Type:        schema.TypeString,
@@ -109,7 +110,9 @@ func resourceFmcAccessPoliciesCategoryDelete(ctx context.Context, d *schema.Reso
 	accessPolicyID := d.Get("access_policy_id").(string)
 
 	err := c.DeleteFmcAccessPoliciesCategory(ctx, id, accessPolicyID)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("access policy category", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete access policy category",
@@ -3,10 +3,14 @@ package fmc
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -23,17 +27,145 @@ func TestAccFmcAccessPolicyBasic(t *testing.T) {
 				Config: testAccCheckFmcAccessPolicyConfigBasic(name, default_action),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFmcAccessPolicyExists("fmc_access_policies.test"),
+					resource.TestCheckResourceAttrSet("fmc_access_policies.test", "default_action_id"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccFmcAccessPolicyNewName(t *testing.T) {
+	name := "test_access_policy_new_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcAccessPolicyConfigNewName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcAccessPolicyExists("fmc_access_policy.test"),
+					resource.TestCheckResourceAttr("fmc_access_policy.test", "default_action", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFmcAccessPolicyUpdate(t *testing.T) {
+	name := "test_access_policy_update"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcAccessPolicyConfigUpdate(name, "Before update", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcAccessPolicyExists("fmc_access_policy.test"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["fmc_access_policy.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckFmcAccessPolicyConfigUpdate(name, "After update", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fmc_access_policy.test", "default_action_log_begin", "true"),
+					resource.TestCheckResourceAttr("fmc_access_policy.test", "default_action_enable_syslog", "true"),
+					resource.TestCheckResourceAttr("fmc_access_policy.test", "default_action_syslog_severity", "WARNING"),
+					func(s *terraform.State) error {
+						if updated := s.RootModule().Resources["fmc_access_policy.test"].Primary.ID; updated != id {
+							return fmt.Errorf("access policy was recreated: %s, expected %s", updated, id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "fmc_access_policy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_references"},
+			},
+		},
+	})
+}
+
+func TestAccFmcAccessPolicyImportSettings(t *testing.T) {
+	parentName := "test_access_policy_import_parent"
+	name := "test_access_policy_import"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcAccessPolicyConfigImportSettings(parentName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcAccessPolicyExists("fmc_access_policy.test"),
+					resource.TestCheckResourceAttr("fmc_access_policy.test", "advanced.0.inspect_traffic_during_apply", "false"),
+					resource.TestCheckResourceAttr("fmc_access_policy.test", "security_intelligence.0.url_logging", "false"),
+				),
+			},
+			{
+				// The base policy and the settings blocks are read on import
+				ResourceName:            "fmc_access_policy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_references"},
+			},
+		},
+	})
+}
+
+func TestAccFmcAccessPolicyInheritFromParent(t *testing.T) {
+	parentName := "test_access_policy_parent"
+	name := "test_access_policy_child"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcAccessPolicyConfigInheritFromParent(parentName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcAccessPolicyExists("fmc_access_policies.test"),
+					resource.TestCheckResourceAttr("fmc_access_policies.test", "default_action", "INHERIT_FROM_PARENT"),
+					resource.TestCheckResourceAttrPair("fmc_access_policies.test", "base_policy_id", "fmc_access_policies.parent", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFmcAccessPolicyValidateSyslogConfig(t *testing.T) {
+	name := "test_access_policy_syslog"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckFmcAccessPolicyConfigValidateSyslogConfig(name),
+				ExpectError: regexp.MustCompile("default_action_syslog_config_id .* is not a SyslogAlert"),
+			},
+		},
+	})
+}
+
 func testAccCheckFmcAccessPolicyDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "fmc_access_policies" {
+		if rs.Type != "fmc_access_policy" && rs.Type != "fmc_access_policies" {
 			continue
 		}
 
@@ -59,6 +191,77 @@ func testAccCheckFmcAccessPolicyConfigBasic(name, default_action string) string
     `, name, default_action)
 }
 
+func testAccCheckFmcAccessPolicyConfigNewName(name string) string {
+	return fmt.Sprintf(`
+    resource "fmc_access_policy" "test" {
+        name           = "%s"
+        default_action = "block"
+    }
+    `, name)
+}
+
+func testAccCheckFmcAccessPolicyConfigUpdate(name, description string, logBegin bool) string {
+	return fmt.Sprintf(`
+    resource "fmc_access_policy" "test" {
+        name                              = "%s"
+        description                       = "%s"
+        default_action                    = "block"
+        default_action_send_events_to_fmc = true
+        default_action_log_begin          = %[3]t
+        default_action_enable_syslog      = %[3]t
+        default_action_syslog_severity    = "warning"
+    }
+    `, name, description, logBegin)
+}
+
+func testAccCheckFmcAccessPolicyConfigInheritFromParent(parentName, name string) string {
+	return fmt.Sprintf(`
+    resource "fmc_access_policies" "parent" {
+        name           = "%s"
+        default_action = "block"
+    }
+    resource "fmc_access_policies" "test" {
+        name           = "%s"
+        default_action = "inherit_from_parent"
+        base_policy_id = fmc_access_policies.parent.id
+    }
+    `, parentName, name)
+}
+
+func testAccCheckFmcAccessPolicyConfigImportSettings(parentName, name string) string {
+	return fmt.Sprintf(`
+    resource "fmc_access_policy" "parent" {
+        name           = "%s"
+        default_action = "block"
+    }
+    resource "fmc_access_policy" "test" {
+        name           = "%s"
+        default_action = "inherit_from_parent"
+        base_policy_id = fmc_access_policy.parent.id
+        advanced {
+            inspect_traffic_during_apply = false
+        }
+        security_intelligence {
+            url_logging = false
+        }
+    }
+    `, parentName, name)
+}
+
+func testAccCheckFmcAccessPolicyConfigValidateSyslogConfig(name string) string {
+	return fmt.Sprintf(`
+    data "fmc_security_zones" "inside" {
+        name = "inside"
+    }
+    resource "fmc_access_policy" "test" {
+        name                            = "%s"
+        default_action                  = "block"
+        default_action_syslog_config_id = data.fmc_security_zones.inside.id
+        validate_references             = true
+    }
+    `, name)
+}
+
 func testAccCheckFmcAccessPolicyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -74,3 +277,146 @@ func testAccCheckFmcAccessPolicyExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestImportAccessPolicySettings(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/inheritancesettings/"):
+			_, _ = w.Write([]byte(`{"baseAccessPolicy": {"id": "parent", "type": "AccessPolicy"}}`))
+		case strings.Contains(r.URL.Path, "/advancedsettings/"):
+			_, _ = w.Write([]byte(`{"interactiveBlockAllowTime": 600, "identityPolicySetting": {"id": "identity", "type": "IdentityPolicy"}}`))
+		case strings.Contains(r.URL.Path, "/securityintelligencepolicies/"):
+			_, _ = w.Write([]byte(`{"networks": {"blockList": [{"id": "feed", "type": "SINetworkFeed"}], "logging": true}, "dnsPolicy": {"id": "dns", "type": "DNSPolicy"}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := resourceFmcAccessPolicies().TestResourceData()
+	d.SetId("acp")
+	if _, err := resourceFmcAccessPoliciesImport(context.Background(), d, c); err != nil {
+		t.Fatal(err)
+	}
+	for key, expected := range map[string]interface{}{
+		"base_policy_id": "parent",
+		"advanced.0.interactive_block_bypass_timeout": 600,
+		"advanced.0.identity_policy_id":               "identity",
+		"security_intelligence.0.network_logging":     true,
+		"security_intelligence.0.dns_policy_id":       "dns",
+	} {
+		if value := d.Get(key); value != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected, value)
+		}
+	}
+	if blockList := d.Get("security_intelligence.0.network_block_list").(*schema.Set); blockList.Len() != 1 {
+		t.Errorf("expected the network block list to be imported, got %v", blockList.List())
+	}
+}
+
+func TestExpandAccessPolicyAdvancedSetting(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFmcAccessPolicies().Schema, map[string]interface{}{
+		"name": "acp",
+		"advanced": []interface{}{map[string]interface{}{
+			"inspect_traffic_during_apply": false,
+			"identity_policy_id":           "identity",
+		}},
+	})
+	// Settings read from FMC
+	setting := &AccessPolicyAdvancedSetting{
+		Enabletlsserveridentitydiscovery: true,
+		Interactiveblockallowtime:        600,
+		Inspecttrafficduringapply:        true,
+	}
+	expandAccessPolicyAdvancedSetting(d, setting)
+
+	expected := &AccessPolicyAdvancedSetting{
+		Enabletlsserveridentitydiscovery: true,
+		Interactiveblockallowtime:        600,
+		Inspecttrafficduringapply:        false,
+		Identitypolicysetting:            &AccessPolicySubConfig{ID: "identity", Type: identity_policy_type},
+	}
+	if !reflect.DeepEqual(setting, expected) {
+		t.Errorf("expected %+v, got %+v", expected, setting)
+	}
+}
+
+func TestValidateAccessPolicyDefaultAction(t *testing.T) {
+	for _, test := range []struct {
+		action   string
+		settings map[string]bool
+		valid    bool
+	}{
+		{"permit", map[string]bool{"default_action_base_intrusion_policy_id": true, "default_action_enable_syslog": true}, true},
+		{"block", map[string]bool{"default_action_base_intrusion_policy_id": true}, false},
+		{"BLOCK", map[string]bool{"default_action_base_intrusion_policy_id": false, "default_action_syslog_config_id": true}, true},
+		{"trust", map[string]bool{"default_action_base_intrusion_policy_id": true}, false},
+		{"network_discovery", map[string]bool{"default_action_enable_syslog": true}, false},
+		{"NETWORK_DISCOVERY", map[string]bool{"default_action_syslog_config_id": true}, false},
+		{"NETWORK_DISCOVERY", map[string]bool{"default_action_enable_syslog": false}, true},
+	} {
+		if err := validateAccessPolicyDefaultAction(test.action, test.settings); (err == nil) != test.valid {
+			t.Errorf("expected %s with %v to be valid: %t, got %v", test.action, test.settings, test.valid, err)
+		}
+	}
+}
+
+func TestExpandAccessPolicyPrefilterPolicy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFmcAccessPolicies().Schema, map[string]interface{}{
+		"name": "acp",
+	})
+	if prefilterPolicy := expandAccessPolicyPrefilterPolicy(d); prefilterPolicy != nil {
+		t.Errorf("expected the prefilter policy assigned by FMC to be kept, got %+v", prefilterPolicy)
+	}
+	d = schema.TestResourceDataRaw(t, resourceFmcAccessPolicies().Schema, map[string]interface{}{
+		"name":                "acp",
+		"prefilter_policy_id": "prefilter",
+	})
+	expected := &AccessPolicySubConfig{ID: "prefilter", Type: prefilterPolicyType}
+	if prefilterPolicy := expandAccessPolicyPrefilterPolicy(d); !reflect.DeepEqual(prefilterPolicy, expected) {
+		t.Errorf("expected %+v, got %+v", expected, prefilterPolicy)
+	}
+}
+
+func TestExpandAccessPolicySecurityIntelligence(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFmcAccessPolicies().Schema, map[string]interface{}{
+		"name": "acp",
+		"security_intelligence": []interface{}{map[string]interface{}{
+			"network_block_list": []interface{}{map[string]interface{}{
+				"id":   "feed",
+				"type": "SINetworkFeed",
+			}},
+			"url_logging":   false,
+			"dns_policy_id": "dns",
+		}},
+	})
+	// Settings read from FMC
+	setting := &AccessPolicySecurityIntelligence{
+		Networks: AccessPolicySecurityIntelligenceObjects{
+			Donotblocklist: []AccessPolicySubConfig{{ID: "global", Type: "SINetworkList"}},
+			Logging:        true,
+		},
+		Urls: AccessPolicySecurityIntelligenceObjects{
+			Logging: true,
+		},
+	}
+	expandAccessPolicySecurityIntelligence(d, setting)
+
+	expected := &AccessPolicySecurityIntelligence{
+		Networks: AccessPolicySecurityIntelligenceObjects{
+			Blocklist:      []AccessPolicySubConfig{{ID: "feed", Type: "SINetworkFeed"}},
+			Donotblocklist: []AccessPolicySubConfig{},
+			Logging:        true,
+		},
+		Urls: AccessPolicySecurityIntelligenceObjects{
+			Blocklist:      []AccessPolicySubConfig{},
+			Donotblocklist: []AccessPolicySubConfig{},
+			Logging:        false,
+		},
+		Dnspolicy: &AccessPolicySubConfig{ID: "dns", Type: dns_policy_type},
+	}
+	if !reflect.DeepEqual(setting, expected) {
+		t.Errorf("expected %+v, got %+v", expected, setting)
+	}
+}
@@ -12,6 +12,85 @@ import (
 
 var access_policies_type string = "AccessRule"
 
+// Types of the literals and URL categories in the conditions of access rules
+var url_literal_type string = "Url"
+var url_category_type string = "URLCategory"
+var url_category_with_reputation_type string = "UrlCategoryAndReputation"
+var vlan_tag_literal_type string = "VlanTagLiteral"
+var port_literal_type string = "PortLiteral"
+
+// Networks of the "any" shorthands of network literals
+var any_network_literals = map[string][]string{
+	"any":      {"0.0.0.0/0", "::/0"},
+	"any-ipv4": {"0.0.0.0/0"},
+	"any-ipv6": {"::/0"},
+}
+
+// Types of the interface objects which can be used as source or destination zones, loopback and VTI interfaces
+// are in security zones and tunnel zones are of type TunnelTag
+var access_rule_zone_types = []string{"SecurityZone", "InterfaceGroup", "TunnelTag"}
+
+// IP protocol numbers of the protocols of port literals which can be set by name
+var ip_protocol_numbers = map[string]string{
+	"TCP": "6",
+	"UDP": "17",
+}
+
+func validateAccessRuleZoneType(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	for _, allowed := range access_rule_zone_types {
+		if v == allowed {
+			return
+		}
+	}
+	errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, access_rule_zone_types, v))
+	return
+}
+
+// The types of the literal networks in FMC by their kind
+var access_rule_network_literal_types = map[string]string{
+	ip_value_host:    "Host",
+	ip_value_network: "Network",
+	ip_value_range:   "Range",
+}
+
+// accessRuleNetworkLiteralKind returns the kind of the literal network, a host, a network or a range
+func accessRuleNetworkLiteralKind(value string) (string, error) {
+	addresses, prefix, err := parseIPValue(value)
+	switch {
+	case err != nil:
+		return "", err
+	case len(addresses) == 2:
+		return ip_value_range, nil
+	case prefix != -1:
+		return ip_value_network, nil
+	}
+	return ip_value_host, nil
+}
+
+func validateAccessRuleNetworkLiteral(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if _, ok := any_network_literals[strings.ToLower(v)]; ok {
+		return
+	}
+	kind, err := accessRuleNetworkLiteralKind(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q must be an IPv4 or IPv6 host, network or range, or any, any-ipv4 or any-ipv6, got: %s", key, err.Error()))
+		return
+	}
+	return validateIPValue(kind)(v, key)
+}
+
+// suppressDefaultVlanEndTag ignores the end tag read from FMC when it is not set, FMC returns the start tag as the
+// end of a single VLAN tag
+func suppressDefaultVlanEndTag(k, old, new string, d *schema.ResourceData) bool {
+	if new != "" && new != "0" {
+		return false
+	}
+	startTag := d.Get(strings.TrimSuffix(k, "end_tag") + "start_tag").(int)
+	return old == strconv.Itoa(startTag)
+}
+
 func resourceFmcAccessRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource for Access Rules in FMC\n" +
@@ -20,7 +99,7 @@ func resourceFmcAccessRules() *schema.Resource {
 			"An example is shown below: \n" +
 			"```hcl\n" +
 			"resource \"fmc_access_rules\" \"access_rule_1\" {\n" +
-			"    acp = fmc_access_policies.access_policy.id\n" +
+			"    acp = fmc_access_policy.access_policy.id\n" +
 			"    section = \"mandatory\"\n" +
 			"    name = \"Test rule 1\"\n" +
 			"    action = \"allow\"\n" +
@@ -51,6 +130,7 @@ func resourceFmcAccessRules() *schema.Resource {
 			"            id = data.fmc_network_objects.source.id\n" +
 			"            type =  data.fmc_network_objects.source.type\n" +
 			"        }\n" +
+			"        literal = [ \"10.20.0.0/16\", \"2001:db8::/32\" ]\n" +
 			"    }\n" +
 			"    destination_networks {\n" +
 			"        destination_network {\n" +
@@ -63,12 +143,23 @@ func resourceFmcAccessRules() *schema.Resource {
 			"            id = data.fmc_port_objects.http.id\n" +
 			"            type =  data.fmc_port_objects.http.type\n" +
 			"        }\n" +
+			"        literal {\n" +
+			"            protocol = \"TCP\"\n" +
+			"            port = \"8443\"\n" +
+			"        }\n" +
 			"    }\n" +
 			"    urls {\n" +
 			"        url {\n" +
 			"            id = fmc_url_objects.dest_url.id\n" +
 			"            type = \"Url\"\n" +
 			"        }\n" +
+			"        literal = [ \"example.com\" ]\n" +
+			"    }\n" +
+			"    vlan_tags {\n" +
+			"        literal {\n" +
+			"            start_tag = 10\n" +
+			"            end_tag = 20\n" +
+			"        }\n" +
 			"    }\n" +
 			"    ips_policy = data.fmc_ips_policies.ips_policy.id\n" +
 			"    syslog_config = data.fmc_syslog_alerts.syslog_alert.id\n" +
@@ -76,7 +167,7 @@ func resourceFmcAccessRules() *schema.Resource {
 			"}\n" +
 			"\n" +
 			"resource \"fmc_access_rules\" \"access_rule_2\" {\n" +
-			"    acp = fmc_access_policies.access_policy.id\n" +
+			"    acp = fmc_access_policy.access_policy.id\n" +
 			"    section = \"mandatory\"\n" +
 			"    insert_before = 1 # Wont work as assumed since terraform does not \n" +
 			"    name = \"Test rule 2\"\n" +
@@ -126,6 +217,13 @@ func resourceFmcAccessRules() *schema.Resource {
 			"            id = fmc_url_objects.dest_url.id\n" +
 			"            type = \"Url\"\n" +
 			"        }\n" +
+			"        literal = [ \"example.com\" ]\n" +
+			"    }\n" +
+			"    vlan_tags {\n" +
+			"        literal {\n" +
+			"            start_tag = 10\n" +
+			"            end_tag = 20\n" +
+			"        }\n" +
 			"    }\n" +
 			"    ips_policy = data.fmc_ips_policies.ips_policy.id\n" +
 			"    syslog_config = data.fmc_syslog_alerts.syslog_alert.id\n" +
@@ -135,11 +233,38 @@ func resourceFmcAccessRules() *schema.Resource {
 			"    ]\n" +
 			"}\n" +
 			"```\n" +
-			"**Note** If creating multiple rules during a single `terraform apply`, remember to use `depends_on` to chain the rules so that terraform creates it in the same order that you intended.",
+			"**Note** If creating multiple rules during a single `terraform apply`, remember to use `depends_on` to chain the rules so that terraform creates it in the same order that you intended.\n" +
+			"\n" +
+			"**Note** `rule_index` is read back from FMC, so a rule moved e.g. in the FMC UI can be detected with a `postcondition` on it.\n" +
+			"\n" +
+			"**Note** Rules of the same access policy created or destroyed while another one is in flight are sent together with bulk requests, " +
+			"raise `-parallelism` to send more rules per request for large policies. Rules chained with `depends_on` are created one by one without delay. " +
+			"If a bulk create fails, all the rules in it fail with the error of FMC.\n" +
+			"\n" +
+			"**Note** Set `validate_references` to check during plan that all the referenced objects still exist in FMC, which catches stale IDs of deleted objects before the apply. " +
+			"This sends a request per referenced object on every plan, which adds up for large policies.\n" +
+			"\n" +
+			"**Note** The time range is evaluated in the timezone assigned to the device, " +
+			"rules with a time range never match on devices without a timezone object assigned in their platform settings, see `fmc_ftd_timezone`. " +
+			"With `validate_references`, the plan fails if a device of the access policy has no timezone.\n" +
+			"\n" +
+			"**Note** `source_security_group_tags`, `applications` and `users` take the IDs and types of the objects in FMC, " +
+			"e.g. `ISESecurityGroupTag`, `Application` and `RealmUser` or `RealmUserGroup`. URL categories are referenced by the ID of the `URLCategory`.\n" +
+			"\n" +
+			"**Note** Networks and ports can be given as literals instead of objects, e.g. `literal = [ \"any-ipv4\" ]`. " +
+			"The `any` literal stands for both `0.0.0.0/0` and `::/0`, and a port literal with the port `any` for all the ports of its protocol. " +
+			"A condition block left out matches any value.\n" +
+			"\n" +
+			"**Note** `safe_search` and `youtube_edu` are only supported by FMC versions which support content restriction in access rules.\n" +
+			"\n" +
+			"**Note** The FMC API has no sampling of the connection events of a rule. To keep high-volume rules from flooding the event database, " +
+			"log only at the end of the connections with `log_end`, leave `log_begin` unset, and send the events to syslog instead of FMC with " +
+			"`enable_syslog` and `send_events_to_fmc = false`.",
 		CreateContext: resourceFmcAccessRulesCreate,
 		ReadContext:   resourceFmcAccessRulesRead,
 		UpdateContext: resourceFmcAccessRulesUpdate,
 		DeleteContext: resourceFmcAccessRulesDelete,
+		CustomizeDiff: resourceFmcAccessRulesCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"acp": {
 				Type:        schema.TypeString,
@@ -204,10 +329,16 @@ func resourceFmcAccessRules() *schema.Resource {
 				},
 				Description: "The rule number after which to insert this resource",
 			},
+			"rule_index": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The effective position of this resource in the ACP",
+			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the resourceFmc",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_access_rule_name_max_length),
+				Description:  "The name of the resourceFmc",
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -305,9 +436,10 @@ func resourceFmcAccessRules() *schema.Resource {
 										Description: "The ID of this resource",
 									},
 									"type": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The type of this resource",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAccessRuleZoneType,
+										Description:  `The type of this resource, "SecurityZone", "InterfaceGroup" or "TunnelTag"`,
 									},
 								},
 							},
@@ -333,9 +465,10 @@ func resourceFmcAccessRules() *schema.Resource {
 										Description: "The ID of this resource",
 									},
 									"type": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The type of this resource",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAccessRuleZoneType,
+										Description:  `The type of this resource, "SecurityZone", "InterfaceGroup" or "TunnelTag"`,
 									},
 								},
 							},
@@ -352,7 +485,7 @@ func resourceFmcAccessRules() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"source_network": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"id": {
@@ -368,9 +501,19 @@ func resourceFmcAccessRules() *schema.Resource {
 								},
 							},
 						},
+						"literal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateFunc:     validateAccessRuleNetworkLiteral,
+								DiffSuppressFunc: suppressEquivalentIPValues,
+							},
+							Description: "Literal IPv4 or IPv6 hosts, networks or ranges, e.g. \"10.0.0.0/8\" or \"2001:db8::1-2001:db8::9\", or \"any\", \"any-ipv4\" and \"any-ipv6\" for all the networks",
+						},
 					},
 				},
-				Description: "Source networks for this resource",
+				Description: "Source networks and literal source networks for this resource",
 			},
 			"destination_networks": {
 				Type:     schema.TypeList,
@@ -380,7 +523,7 @@ func resourceFmcAccessRules() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"destination_network": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"id": {
@@ -396,9 +539,19 @@ func resourceFmcAccessRules() *schema.Resource {
 								},
 							},
 						},
+						"literal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateFunc:     validateAccessRuleNetworkLiteral,
+								DiffSuppressFunc: suppressEquivalentIPValues,
+							},
+							Description: "Literal IPv4 or IPv6 hosts, networks or ranges, e.g. \"10.0.0.0/8\" or \"2001:db8::1-2001:db8::9\", or \"any\", \"any-ipv4\" and \"any-ipv6\" for all the networks",
+						},
 					},
 				},
-				Description: "Destination networks for this resource",
+				Description: "Destination networks and literal destination networks for this resource",
 			},
 			"source_ports": {
 				Type:     schema.TypeList,
@@ -408,7 +561,7 @@ func resourceFmcAccessRules() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"source_port": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"id": {
@@ -424,9 +577,36 @@ func resourceFmcAccessRules() *schema.Resource {
 								},
 							},
 						},
+						"literal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"protocol": {
+										Type:     schema.TypeString,
+										Required: true,
+										StateFunc: func(val interface{}) string {
+											return accessRulePortProtocolName(val.(string))
+										},
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											return accessRulePortProtocolName(old) == accessRulePortProtocolName(new)
+										},
+										Description: `The protocol, "TCP", "UDP" or an IP protocol number`,
+									},
+									"port": {
+										Type:     schema.TypeString,
+										Optional: true,
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											return accessRuleLiteralPort(old) == accessRuleLiteralPort(new)
+										},
+										Description: "The port or port range, e.g. \"443\" or \"8080-8090\", all ports if \"any\" or not set",
+									},
+								},
+							},
+						},
 					},
 				},
-				Description: "Source ports for this resource",
+				Description: "Source ports and literal source ports for this resource",
 			},
 			"destination_ports": {
 				Type:     schema.TypeList,
@@ -436,7 +616,7 @@ func resourceFmcAccessRules() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"destination_port": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"id": {
@@ -452,9 +632,36 @@ func resourceFmcAccessRules() *schema.Resource {
 								},
 							},
 						},
+						"literal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"protocol": {
+										Type:     schema.TypeString,
+										Required: true,
+										StateFunc: func(val interface{}) string {
+											return accessRulePortProtocolName(val.(string))
+										},
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											return accessRulePortProtocolName(old) == accessRulePortProtocolName(new)
+										},
+										Description: `The protocol, "TCP", "UDP" or an IP protocol number`,
+									},
+									"port": {
+										Type:     schema.TypeString,
+										Optional: true,
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											return accessRuleLiteralPort(old) == accessRuleLiteralPort(new)
+										},
+										Description: "The port or port range, e.g. \"443\" or \"8080-8090\", all ports if \"any\" or not set",
+									},
+								},
+							},
+						},
 					},
 				},
-				Description: "Destination ports for this resource",
+				Description: "Destination ports and literal destination ports for this resource",
 			},
 			"urls": {
 				Type:     schema.TypeList,
@@ -463,6 +670,117 @@ func resourceFmcAccessRules() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"url": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+						},
+						"literal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "Literal URLs, e.g. \"example.com\"",
+						},
+						"category": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of the URL category",
+									},
+									"reputation": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: `The reputations of the URLs in the category, e.g. "ANY_EXCEPT_UNKNOWN", all reputations if not set`,
+									},
+								},
+							},
+						},
+					},
+				},
+				Description: "URLs, literal URLs and URL categories for this resource",
+			},
+			"source_security_group_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_security_group_tag": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+						},
+					},
+				},
+				Description: "Source security group tags (SGTs) for this resource",
+			},
+			"applications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+						},
+					},
+				},
+				Description: "Applications for this resource",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
 							Type:     schema.TypeList,
 							Required: true,
 							Elem: &schema.Resource{
@@ -482,7 +800,54 @@ func resourceFmcAccessRules() *schema.Resource {
 						},
 					},
 				},
-				Description: "URLs for this resource",
+				Description: "Users and user groups of realms for this resource",
+			},
+			"vlan_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vlan_tag": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+						},
+						"literal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_tag": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "The first VLAN tag of the range",
+									},
+									"end_tag": {
+										Type:             schema.TypeInt,
+										Optional:         true,
+										DiffSuppressFunc: suppressDefaultVlanEndTag,
+										Description:      "The last VLAN tag of the range, the start tag if not set",
+									},
+								},
+							},
+						},
+					},
+				},
+				Description: "VLAN tags and literal VLAN tag ranges for this resource",
 			},
 			"ips_policy": {
 				Type:        schema.TypeString,
@@ -499,29 +864,513 @@ func resourceFmcAccessRules() *schema.Resource {
 				Optional:    true,
 				Description: "Syslog configuration ID for this resource",
 			},
+			"time_range": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Time range object ID for this resource, the rule only matches within the time range",
+			},
 			"new_comments": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Description: "New comments to be added for this resource",
+				Description: "New comments to be added for this resource, if empty the fmc_default_comment of the provider is added on every change",
+			},
+			"comment_history": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The FMC user who added the comment",
+						},
+						"date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The comments added to this resource so far, oldest first",
+			},
+			"safe_search": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enforce Safe Search on the search engines matched by this resource",
+			},
+			"safe_search_unsupported_action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"ALLOW", "BLOCK", "BLOCK_RESET", "BLOCK_INTERACTIVE", "BLOCK_RESET_INTERACTIVE"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `Action for search traffic which does not support Safe Search, "ALLOW", "BLOCK", "BLOCK_RESET", "BLOCK_INTERACTIVE" or "BLOCK_RESET_INTERACTIVE"`,
+			},
+			"youtube_edu": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Restrict YouTube to YouTube EDU for the traffic matched by this resource",
+			},
+			"youtube_edu_custom_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "YouTube EDU custom ID of the school or district",
+			},
+			"youtube_edu_unsupported_action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"ALLOW", "BLOCK", "BLOCK_RESET", "BLOCK_INTERACTIVE", "BLOCK_RESET_INTERACTIVE"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `Action for YouTube traffic which does not support YouTube EDU, "ALLOW", "BLOCK", "BLOCK_RESET", "BLOCK_INTERACTIVE" or "BLOCK_RESET_INTERACTIVE"`,
+			},
+			"validate_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check during plan that all the objects referenced by this resource exist in FMC",
 			},
 		},
 	}
 }
 
+// resourceFmcAccessRulesCustomizeDiff looks up all the referenced objects if validate_references is set, and checks
+// that the devices of the policy have a timezone if the rule has a time range.
+// References which are not known yet, e.g. objects created in the same apply, are skipped.
+func resourceFmcAccessRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	c := m.(*Client)
+
+	if !d.Get("validate_references").(bool) {
+		return nil
+	}
+
+	// A rule with a missing time range is rejected by FMC only at deploy time, and never matches on devices without a timezone
+	if id := d.Get("time_range").(string); id != "" {
+		if _, err := c.GetFmcTimeRangeObject(ctx, id); err != nil {
+			return fmt.Errorf("time range %s could not be found - %s", id, err.Error())
+		}
+		if acp := d.Get("acp").(string); acp != "" {
+			devices, err := c.GetFmcDevicesWithoutTimezone(ctx, acp)
+			if err != nil {
+				return fmt.Errorf("checking the timezone of the devices of access policy %s - %s", acp, err.Error())
+			}
+			if len(devices) > 0 {
+				return fmt.Errorf("time range %s is evaluated in a timezone, but the devices %s of access policy %s have no timezone object in their FTD platform settings, see fmc_ftd_timezone", id, strings.Join(devices, ", "), acp)
+			}
+		}
+	}
+
+	var missing []string
+	for _, objType := range []string{"source_zones", "destination_zones", "source_networks", "destination_networks", "source_ports", "destination_ports", "urls", "source_security_group_tags", "applications", "users", "vlan_tags"} {
+		inputEntries := d.Get(objType).([]interface{})
+		if len(inputEntries) == 0 || inputEntries[0] == nil {
+			continue
+		}
+		entries := inputEntries[0].(map[string]interface{})[objType[:len(objType)-1]]
+		for _, ent := range entries.([]interface{}) {
+			entry := ent.(map[string]interface{})
+			id, objectType := entry["id"].(string), entry["type"].(string)
+			if id == "" || objectType == "" {
+				continue
+			}
+			if err := c.CheckFmcObjectReference(ctx, objectType, id); err != nil {
+				missing = append(missing, fmt.Sprintf("%s: %s %s - %s", objType, objectType, id, err.Error()))
+			}
+		}
+	}
+
+	for objType, objectType := range map[string]string{"ips_policy": "IntrusionPolicy", "file_policy": "FilePolicy", "syslog_config": "SyslogAlert"} {
+		id := d.Get(objType).(string)
+		if id == "" {
+			continue
+		}
+		if err := c.CheckFmcObjectReference(ctx, objectType, id); err != nil {
+			missing = append(missing, fmt.Sprintf("%s: %s %s - %s", objType, objectType, id, err.Error()))
+		}
+	}
+
+	if urls := d.Get("urls").([]interface{}); len(urls) > 0 && urls[0] != nil {
+		for _, ent := range urls[0].(map[string]interface{})["category"].([]interface{}) {
+			id := ent.(map[string]interface{})["id"].(string)
+			if id == "" {
+				continue
+			}
+			if err := c.CheckFmcObjectReference(ctx, url_category_type, id); err != nil {
+				missing = append(missing, fmt.Sprintf("urls: %s %s - %s", url_category_type, id, err.Error()))
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("referenced objects could not be found:\n%s", strings.Join(missing, "\n"))
+	}
+	return nil
+}
+
+// expandAccessRuleComments returns the new comments of the rule, or the default comment of the provider
+// for the operation if there are none
+func expandAccessRuleComments(c *Client, d *schema.ResourceData, operation string) []string {
+	comments := []string{}
+	for _, comment := range d.Get("new_comments").([]interface{}) {
+		comments = append(comments, comment.(string))
+	}
+	if len(comments) == 0 && c.defaultCommentTemplate != "" {
+		comments = append(comments, c.defaultComment(d.Get("name").(string), operation))
+	}
+	return comments
+}
+
+// expandAccessRuleContentRestriction returns the Safe Search and YouTube EDU settings of the rule,
+// nil if disabled so that they are not sent to FMC versions which do not support them
+func expandAccessRuleContentRestriction(d *schema.ResourceData) (*AccessRuleSafeSearch, *AccessRuleYoutubeEdu) {
+	var safeSearch *AccessRuleSafeSearch
+	var youtubeEdu *AccessRuleYoutubeEdu
+	if d.Get("safe_search").(bool) {
+		safeSearch = &AccessRuleSafeSearch{
+			Enabled:                  true,
+			Unsupportedsearchtraffic: strings.ToUpper(d.Get("safe_search_unsupported_action").(string)),
+		}
+	}
+	if d.Get("youtube_edu").(bool) {
+		youtubeEdu = &AccessRuleYoutubeEdu{
+			Enabled:                  true,
+			Customid:                 d.Get("youtube_edu_custom_id").(string),
+			Unsupportedsearchtraffic: strings.ToUpper(d.Get("youtube_edu_unsupported_action").(string)),
+		}
+	}
+	return safeSearch, youtubeEdu
+}
+
+// expandAccessRuleObjects returns the objects of a condition block of the rule, e.g. the users of "users"
+func expandAccessRuleObjects(d *schema.ResourceData, objType string) []AccessRuleSubConfig {
+	var objects []AccessRuleSubConfig
+	if inputEntries, ok := d.GetOk(objType); ok && inputEntries.([]interface{})[0] != nil {
+		entries := inputEntries.([]interface{})[0].(map[string]interface{})[objType[:len(objType)-1]]
+		for _, ent := range entries.([]interface{}) {
+			entry := ent.(map[string]interface{})
+			objects = append(objects, AccessRuleSubConfig{
+				ID:   entry["id"].(string),
+				Type: entry["type"].(string),
+			})
+		}
+	}
+	return objects
+}
+
+// accessRuleBlock returns the settings of a condition block of the rule, nil if not configured
+func accessRuleBlock(d *schema.ResourceData, objType string) map[string]interface{} {
+	if inputEntries, ok := d.GetOk(objType); ok && inputEntries.([]interface{})[0] != nil {
+		return inputEntries.([]interface{})[0].(map[string]interface{})
+	}
+	return nil
+}
+
+// accessRulePortProtocolName returns the name of the protocol if it has one, e.g. "TCP" for "6", or its number
+func accessRulePortProtocolName(protocol string) string {
+	for name, number := range ip_protocol_numbers {
+		if protocol == number || strings.EqualFold(protocol, name) {
+			return name
+		}
+	}
+	return protocol
+}
+
+// accessRulePortProtocolNumber returns the IP protocol number which FMC expects, e.g. "6" for "TCP"
+func accessRulePortProtocolNumber(protocol string) string {
+	if number, ok := ip_protocol_numbers[strings.ToUpper(protocol)]; ok {
+		return number
+	}
+	return protocol
+}
+
+// expandAccessRuleNetworks returns a network condition of the rule, the network objects and the literal networks
+func expandAccessRuleNetworks(d *schema.ResourceData, objType string) AccessRuleNetworks {
+	networks := AccessRuleNetworks{
+		Objects: expandAccessRuleObjects(d, objType),
+	}
+	if block := accessRuleBlock(d, objType); block != nil {
+		for _, literal := range block["literal"].([]interface{}) {
+			value := literal.(string)
+			if anyNetworks, ok := any_network_literals[strings.ToLower(value)]; ok {
+				for _, anyNetwork := range anyNetworks {
+					networks.Literals = append(networks.Literals, AccessRuleNetworkLiteral{
+						Type:  "Network",
+						Value: anyNetwork,
+					})
+				}
+				continue
+			}
+			// The literals are validated by the schema, an invalid value is left for FMC to reject
+			kind, err := accessRuleNetworkLiteralKind(value)
+			if err != nil {
+				kind = ip_value_host
+			}
+			networks.Literals = append(networks.Literals, AccessRuleNetworkLiteral{
+				Type:  access_rule_network_literal_types[kind],
+				Value: value,
+			})
+		}
+	}
+	return networks
+}
+
+// expandAccessRulePorts returns a port condition of the rule, the port objects and the literal ports
+func expandAccessRulePorts(d *schema.ResourceData, objType string) AccessRulePorts {
+	ports := AccessRulePorts{
+		Objects: expandAccessRuleObjects(d, objType),
+	}
+	if block := accessRuleBlock(d, objType); block != nil {
+		for _, ent := range block["literal"].([]interface{}) {
+			entry := ent.(map[string]interface{})
+			ports.Literals = append(ports.Literals, AccessRulePortLiteral{
+				Type:     port_literal_type,
+				Protocol: accessRulePortProtocolNumber(entry["protocol"].(string)),
+				Port:     accessRuleLiteralPort(entry["port"].(string)),
+			})
+		}
+	}
+	return ports
+}
+
+// accessRuleLiteralPort returns the port of a port literal sent to FMC, empty for all the ports
+func accessRuleLiteralPort(port string) string {
+	if strings.EqualFold(port, "any") {
+		return ""
+	}
+	return port
+}
+
+// collapseAnyNetworkLiterals replaces the networks of the "any" shorthands in the configured literals by the
+// shorthands, so that the literals read from FMC match the configuration
+func collapseAnyNetworkLiterals(literals []interface{}, configured []interface{}) []interface{} {
+	collapsed := append([]interface{}{}, literals...)
+	for _, entry := range configured {
+		shorthand, _ := entry.(string)
+		anyNetworks, ok := any_network_literals[strings.ToLower(shorthand)]
+		if !ok {
+			continue
+		}
+		indexes := []int{}
+		for _, anyNetwork := range anyNetworks {
+			for i, literal := range collapsed {
+				if value, ok := literal.(string); ok && equivalentIPValues(value, anyNetwork) {
+					indexes = append(indexes, i)
+					break
+				}
+			}
+		}
+		if len(indexes) != len(anyNetworks) {
+			continue
+		}
+		// Keep the shorthand at the place of its first network
+		collapsed[indexes[0]] = shorthand
+		for _, i := range indexes[1:] {
+			collapsed[i] = nil
+		}
+		remaining := []interface{}{}
+		for _, literal := range collapsed {
+			if literal != nil {
+				remaining = append(remaining, literal)
+			}
+		}
+		collapsed = remaining
+	}
+	return collapsed
+}
+
+// expandAccessRuleURLs returns the URL condition of the rule, the URL objects, literal URLs and URL categories
+func expandAccessRuleURLs(d *schema.ResourceData) AccessRuleURLs {
+	urls := AccessRuleURLs{
+		Objects:                     expandAccessRuleObjects(d, "urls"),
+		Urlcategorieswithreputation: expandAccessRuleURLCategories(d),
+	}
+	if block := accessRuleBlock(d, "urls"); block != nil {
+		for _, literal := range block["literal"].([]interface{}) {
+			urls.Literals = append(urls.Literals, AccessRuleURLLiteral{
+				Type: url_literal_type,
+				URL:  literal.(string),
+			})
+		}
+	}
+	return urls
+}
+
+func expandAccessRuleURLCategories(d *schema.ResourceData) []AccessRuleURLCategory {
+	var categories []AccessRuleURLCategory
+	if block := accessRuleBlock(d, "urls"); block != nil {
+		for _, ent := range block["category"].([]interface{}) {
+			entry := ent.(map[string]interface{})
+			categories = append(categories, AccessRuleURLCategory{
+				Type: url_category_with_reputation_type,
+				Category: AccessRuleSubConfig{
+					ID:   entry["id"].(string),
+					Type: url_category_type,
+				},
+				Reputation: strings.ToUpper(entry["reputation"].(string)),
+			})
+		}
+	}
+	return categories
+}
+
+// expandAccessRuleMatchCriteria returns the SGT, application, user and VLAN tag conditions of the rule,
+// nil if not configured so that they are not sent to FMC versions which do not support them
+func expandAccessRuleMatchCriteria(d *schema.ResourceData) (*AccessRuleSubConfigs, *AccessRuleApplications, *AccessRuleSubConfigs, *AccessRuleVlanTags) {
+	var sourceSecurityGroupTags, users *AccessRuleSubConfigs
+	var applications *AccessRuleApplications
+	var vlanTags *AccessRuleVlanTags
+	if objects := expandAccessRuleObjects(d, "source_security_group_tags"); len(objects) > 0 {
+		sourceSecurityGroupTags = &AccessRuleSubConfigs{Objects: objects}
+	}
+	if objects := expandAccessRuleObjects(d, "applications"); len(objects) > 0 {
+		applications = &AccessRuleApplications{Applications: objects}
+	}
+	if objects := expandAccessRuleObjects(d, "users"); len(objects) > 0 {
+		users = &AccessRuleSubConfigs{Objects: objects}
+	}
+	if block := accessRuleBlock(d, "vlan_tags"); block != nil {
+		vlanTags = &AccessRuleVlanTags{
+			Objects: expandAccessRuleObjects(d, "vlan_tags"),
+		}
+		for _, ent := range block["literal"].([]interface{}) {
+			entry := ent.(map[string]interface{})
+			endTag := entry["end_tag"].(int)
+			if endTag == 0 {
+				endTag = entry["start_tag"].(int)
+			}
+			vlanTags.Literals = append(vlanTags.Literals, AccessRuleVlanTagLiteral{
+				Type:     vlan_tag_literal_type,
+				Starttag: entry["start_tag"].(int),
+				Endtag:   endTag,
+			})
+		}
+	}
+	return sourceSecurityGroupTags, applications, users, vlanTags
+}
+
+// flattenAccessRuleLiterals returns the literals of the rule, keyed by their condition block
+func flattenAccessRuleLiterals(item *AccessRuleResponse) map[string]map[string]interface{} {
+	urls := map[string]interface{}{}
+	if len(item.Urls.Literals) > 0 {
+		literals := []interface{}{}
+		for _, literal := range item.Urls.Literals {
+			literals = append(literals, literal.URL)
+		}
+		urls["literal"] = literals
+	}
+	if len(item.Urls.Urlcategorieswithreputation) > 0 {
+		categories := []interface{}{}
+		for _, category := range item.Urls.Urlcategorieswithreputation {
+			categories = append(categories, map[string]interface{}{
+				"id":         category.Category.ID,
+				"reputation": category.Reputation,
+			})
+		}
+		urls["category"] = categories
+	}
+	vlanTags := map[string]interface{}{}
+	if len(item.Vlantags.Literals) > 0 {
+		literals := []interface{}{}
+		for _, literal := range item.Vlantags.Literals {
+			literals = append(literals, map[string]interface{}{
+				"start_tag": literal.Starttag,
+				"end_tag":   literal.Endtag,
+			})
+		}
+		vlanTags["literal"] = literals
+	}
+	sourceNetworks, destinationNetworks := map[string]interface{}{}, map[string]interface{}{}
+	for _, networks := range []struct {
+		literals []AccessRuleNetworkLiteral
+		block    map[string]interface{}
+	}{
+		{item.Sourcenetworks.Literals, sourceNetworks},
+		{item.Destinationnetworks.Literals, destinationNetworks},
+	} {
+		if len(networks.literals) > 0 {
+			literals := []interface{}{}
+			for _, literal := range networks.literals {
+				literals = append(literals, literal.Value)
+			}
+			networks.block["literal"] = literals
+		}
+	}
+	sourcePorts, destinationPorts := map[string]interface{}{}, map[string]interface{}{}
+	for _, ports := range []struct {
+		literals []AccessRulePortLiteral
+		block    map[string]interface{}
+	}{
+		{item.Sourceports.Literals, sourcePorts},
+		{item.Destinationports.Literals, destinationPorts},
+	} {
+		if len(ports.literals) > 0 {
+			literals := []interface{}{}
+			for _, literal := range ports.literals {
+				literals = append(literals, map[string]interface{}{
+					"protocol": accessRulePortProtocolName(literal.Protocol),
+					"port":     literal.Port,
+				})
+			}
+			ports.block["literal"] = literals
+		}
+	}
+	return map[string]map[string]interface{}{
+		"source_networks":      sourceNetworks,
+		"destination_networks": destinationNetworks,
+		"source_ports":         sourcePorts,
+		"destination_ports":    destinationPorts,
+		"urls":                 urls,
+		"vlan_tags":            vlanTags,
+	}
+}
+
 func resourceFmcAccessRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 	// Warning or errors can be collected in a slice type
 	// var diags diag.Diagnostics
 	var diags diag.Diagnostics
 
-	var sourceZones, destinationZones, sourceNetworks, destinationNetworks, sourcePorts, destinationPorts, urls []AccessRuleSubConfig
+	var sourceZones, destinationZones []AccessRuleSubConfig
 	dynamicObjects := []*[]AccessRuleSubConfig{
-		&sourceZones, &destinationZones, &sourceNetworks, &destinationNetworks, &sourcePorts, &destinationPorts, &urls,
+		&sourceZones, &destinationZones,
 	}
-	for i, objType := range []string{"source_zones", "destination_zones", "source_networks", "destination_networks", "source_ports", "destination_ports", "urls"} {
+	for i, objType := range []string{"source_zones", "destination_zones"} {
 		if inputEntries, ok := d.GetOk(objType); ok {
 			entries := inputEntries.([]interface{})[0].(map[string]interface{})[objType[:len(objType)-1]]
 			for _, ent := range entries.([]interface{}) {
@@ -546,10 +1395,15 @@ func resourceFmcAccessRulesCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	comments := []string{}
-	for _, comment := range d.Get("new_comments").([]interface{}) {
-		comments = append(comments, comment.(string))
+	var timeRanges []AccessRuleSubConfig
+	if inputEntry, ok := d.GetOk("time_range"); ok {
+		timeRanges = append(timeRanges, AccessRuleSubConfig{
+			ID:   inputEntry.(string),
+			Type: timeRangeObjectType,
+		})
 	}
+
+	comments := expandAccessRuleComments(c, d, "create")
 	var insertBefore, insertAfter string
 	if entry, ok := d.GetOk("insert_before"); ok {
 		insertBefore = strconv.Itoa(entry.(int))
@@ -557,6 +1411,8 @@ func resourceFmcAccessRulesCreate(ctx context.Context, d *schema.ResourceData, m
 	if entry, ok := d.GetOk("insert_after"); ok {
 		insertAfter = strconv.Itoa(entry.(int))
 	}
+	safeSearch, youtubeEdu := expandAccessRuleContentRestriction(d)
+	sourceSecurityGroupTags, applications, users, vlanTags := expandAccessRuleMatchCriteria(d)
 
 	res, err := c.CreateFmcAccessRule(ctx, d.Get("acp").(string), strings.ToLower(d.Get("section").(string)), insertBefore, insertAfter, d.Get("category").(string), &AccessRule{
 		Name:            d.Get("name").(string),
@@ -575,25 +1431,22 @@ func resourceFmcAccessRulesCreate(ctx context.Context, d *schema.ResourceData, m
 		Destinationzones: AccessRuleSubConfigs{
 			Objects: destinationZones,
 		},
-		Sourcenetworks: AccessRuleSubConfigs{
-			Objects: sourceNetworks,
-		},
-		Destinationnetworks: AccessRuleSubConfigs{
-			Objects: destinationNetworks,
-		},
-		Sourceports: AccessRuleSubConfigs{
-			Objects: sourcePorts,
-		},
-		Destinationports: AccessRuleSubConfigs{
-			Objects: destinationPorts,
-		},
-		Urls: AccessRuleSubConfigs{
-			Objects: urls,
-		},
-		Ipspolicy:    ipsPolicy,
-		Filepolicy:   filePolicy,
-		Syslogconfig: syslogConfig,
-		Newcomments:  comments,
+		Sourcenetworks:          expandAccessRuleNetworks(d, "source_networks"),
+		Destinationnetworks:     expandAccessRuleNetworks(d, "destination_networks"),
+		Sourceports:             expandAccessRulePorts(d, "source_ports"),
+		Destinationports:        expandAccessRulePorts(d, "destination_ports"),
+		Urls:                    expandAccessRuleURLs(d),
+		Sourcesecuritygrouptags: sourceSecurityGroupTags,
+		Applications:            applications,
+		Users:                   users,
+		Vlantags:                vlanTags,
+		Ipspolicy:               ipsPolicy,
+		Filepolicy:              filePolicy,
+		Syslogconfig:            syslogConfig,
+		Timerangeobjects:        timeRanges,
+		Newcomments:             comments,
+		Safesearch:              safeSearch,
+		Youtubeedu:              youtubeEdu,
 	})
 	if err != nil {
 		return returnWithDiag(diags, err)
@@ -644,6 +1497,38 @@ func resourceFmcAccessRulesRead(ctx context.Context, d *schema.ResourceData, m i
 	if err := d.Set("log_end", item.Logend); err != nil {
 		return returnWithDiag(diags, err)
 	}
+	if item.Safesearch != nil {
+		if err := d.Set("safe_search", item.Safesearch.Enabled); err != nil {
+			return returnWithDiag(diags, err)
+		}
+		if err := d.Set("safe_search_unsupported_action", item.Safesearch.Unsupportedsearchtraffic); err != nil {
+			return returnWithDiag(diags, err)
+		}
+	} else if err := d.Set("safe_search", false); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	if item.Youtubeedu != nil {
+		if err := d.Set("youtube_edu", item.Youtubeedu.Enabled); err != nil {
+			return returnWithDiag(diags, err)
+		}
+		if err := d.Set("youtube_edu_custom_id", item.Youtubeedu.Customid); err != nil {
+			return returnWithDiag(diags, err)
+		}
+		if err := d.Set("youtube_edu_unsupported_action", item.Youtubeedu.Unsupportedsearchtraffic); err != nil {
+			return returnWithDiag(diags, err)
+		}
+	} else if err := d.Set("youtube_edu", false); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	if err := d.Set("rule_index", item.Metadata.Ruleindex); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	// The section is only read back if configured, so that a rule moved to another section is recreated in the configured one
+	if d.Get("section").(string) != "" && item.Metadata.Section != "" {
+		if err := d.Set("section", strings.ToLower(item.Metadata.Section)); err != nil {
+			return returnWithDiag(diags, err)
+		}
+	}
 	// seems that category is not returned within API response, so that's the only way
 	if err := d.Set("category", d.Get("category").(string)); err != nil {
 		return returnWithDiag(diags, err)
@@ -657,9 +1542,23 @@ func resourceFmcAccessRulesRead(ctx context.Context, d *schema.ResourceData, m i
 		&item.Sourceports.Objects,
 		&item.Destinationports.Objects,
 		&item.Urls.Objects,
+		&item.Sourcesecuritygrouptags.Objects,
+		&item.Applications.Applications,
+		&item.Users.Objects,
+		&item.Vlantags.Objects,
 	}
 
-	dynamicObjectNames := []string{"source_zones", "destination_zones", "source_networks", "destination_networks", "source_ports", "destination_ports", "urls"}
+	dynamicObjectNames := []string{"source_zones", "destination_zones", "source_networks", "destination_networks", "source_ports", "destination_ports", "urls", "source_security_group_tags", "applications", "users", "vlan_tags"}
+
+	// Literals are read into the blocks of their objects
+	literals := flattenAccessRuleLiterals(item)
+	for _, objType := range []string{"source_networks", "destination_networks"} {
+		if networkLiterals, ok := literals[objType]["literal"]; ok {
+			if block := accessRuleBlock(d, objType); block != nil {
+				literals[objType]["literal"] = collapseAnyNetworkLiterals(networkLiterals.([]interface{}), block["literal"].([]interface{}))
+			}
+		}
+	}
 
 	for i, objs := range dynamicObjects {
 		mainResponse := make([]map[string]interface{}, 0)
@@ -671,7 +1570,10 @@ func resourceFmcAccessRulesRead(ctx context.Context, d *schema.ResourceData, m i
 			responseObj["type"] = obj.Type
 			response = append(response, responseObj)
 		}
-		if len(response) != 0 {
+		for key, value := range literals[dynamicObjectNames[i]] {
+			subResponse[key] = value
+		}
+		if len(response) != 0 || len(literals[dynamicObjectNames[i]]) != 0 {
 			subResponse[dynamicObjectNames[i][:len(dynamicObjectNames[i])-1]] = response
 			mainResponse = append(mainResponse, subResponse)
 		}
@@ -693,6 +1595,26 @@ func resourceFmcAccessRulesRead(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
+	timeRange := ""
+	if len(item.Timerangeobjects) > 0 {
+		timeRange = item.Timerangeobjects[0].ID
+	}
+	if err := d.Set("time_range", timeRange); err != nil {
+		return returnWithDiag(diags, err)
+	}
+
+	commentHistory := []interface{}{}
+	for _, comment := range item.Commenthistorylist {
+		commentHistory = append(commentHistory, map[string]interface{}{
+			"comment": comment.Comment,
+			"user":    comment.User.Name,
+			"date":    comment.Date,
+		})
+	}
+	if err := d.Set("comment_history", commentHistory); err != nil {
+		return returnWithDiag(diags, err)
+	}
+
 	return diags
 }
 
@@ -701,12 +1623,12 @@ func resourceFmcAccessRulesUpdate(ctx context.Context, d *schema.ResourceData, m
 	// Warning or errors can be collected in a slice type
 	// var diags diag.Diagnostics
 	var diags diag.Diagnostics
-	if d.HasChanges("name", "type", "action", "syslog_severity", "enable_syslog", "enabled", "send_events_to_fmc", "log_files", "log_begin", "log_end", "source_zones", "destination_zones", "source_networks", "destination_networks", "source_ports", "destination_ports", "urls", "ips_policy", "file_policy", "syslog_config", "new_comments") {
-		var sourceZones, destinationZones, sourceNetworks, destinationNetworks, sourcePorts, destinationPorts, urls []AccessRuleSubConfig
+	if d.HasChanges("name", "type", "action", "syslog_severity", "enable_syslog", "enabled", "send_events_to_fmc", "log_files", "log_begin", "log_end", "source_zones", "destination_zones", "source_networks", "destination_networks", "source_ports", "destination_ports", "urls", "source_security_group_tags", "applications", "users", "vlan_tags", "ips_policy", "file_policy", "syslog_config", "time_range", "new_comments", "safe_search", "safe_search_unsupported_action", "youtube_edu", "youtube_edu_custom_id", "youtube_edu_unsupported_action") {
+		var sourceZones, destinationZones []AccessRuleSubConfig
 		dynamicObjects := []*[]AccessRuleSubConfig{
-			&sourceZones, &destinationZones, &sourceNetworks, &destinationNetworks, &sourcePorts, &destinationPorts, &urls,
+			&sourceZones, &destinationZones,
 		}
-		for i, objType := range []string{"source_zones", "destination_zones", "source_networks", "destination_networks", "source_ports", "destination_ports", "urls"} {
+		for i, objType := range []string{"source_zones", "destination_zones"} {
 			if inputEntries, ok := d.GetOk(objType); ok {
 				entries := inputEntries.([]interface{})[0].(map[string]interface{})[objType[:len(objType)-1]]
 				for _, ent := range entries.([]interface{}) {
@@ -731,10 +1653,17 @@ func resourceFmcAccessRulesUpdate(ctx context.Context, d *schema.ResourceData, m
 			}
 		}
 
-		comments := []string{}
-		for _, comment := range d.Get("new_comments").([]interface{}) {
-			comments = append(comments, comment.(string))
+		var timeRanges []AccessRuleSubConfig
+		if inputEntry, ok := d.GetOk("time_range"); ok {
+			timeRanges = append(timeRanges, AccessRuleSubConfig{
+				ID:   inputEntry.(string),
+				Type: timeRangeObjectType,
+			})
 		}
+
+		comments := expandAccessRuleComments(c, d, "update")
+		safeSearch, youtubeEdu := expandAccessRuleContentRestriction(d)
+		sourceSecurityGroupTags, applications, users, vlanTags := expandAccessRuleMatchCriteria(d)
 		res, err := c.UpdateFmcAccessRule(ctx, d.Get("acp").(string), d.Id(), &AccessRuleUpdate{
 			ID:              d.Id(),
 			Name:            d.Get("name").(string),
@@ -753,25 +1682,22 @@ func resourceFmcAccessRulesUpdate(ctx context.Context, d *schema.ResourceData, m
 			Destinationzones: AccessRuleSubConfigs{
 				Objects: destinationZones,
 			},
-			Sourcenetworks: AccessRuleSubConfigs{
-				Objects: sourceNetworks,
-			},
-			Destinationnetworks: AccessRuleSubConfigs{
-				Objects: destinationNetworks,
-			},
-			Sourceports: AccessRuleSubConfigs{
-				Objects: sourcePorts,
-			},
-			Destinationports: AccessRuleSubConfigs{
-				Objects: destinationPorts,
-			},
-			Urls: AccessRuleSubConfigs{
-				Objects: urls,
-			},
-			Ipspolicy:    ipsPolicy,
-			Filepolicy:   filePolicy,
-			Syslogconfig: syslogConfig,
-			Newcomments:  comments,
+			Sourcenetworks:          expandAccessRuleNetworks(d, "source_networks"),
+			Destinationnetworks:     expandAccessRuleNetworks(d, "destination_networks"),
+			Sourceports:             expandAccessRulePorts(d, "source_ports"),
+			Destinationports:        expandAccessRulePorts(d, "destination_ports"),
+			Urls:                    expandAccessRuleURLs(d),
+			Sourcesecuritygrouptags: sourceSecurityGroupTags,
+			Applications:            applications,
+			Users:                   users,
+			Vlantags:                vlanTags,
+			Ipspolicy:               ipsPolicy,
+			Filepolicy:              filePolicy,
+			Syslogconfig:            syslogConfig,
+			Timerangeobjects:        timeRanges,
+			Newcomments:             comments,
+			Safesearch:              safeSearch,
+			Youtubeedu:              youtubeEdu,
 		})
 		if err != nil {
 			return returnWithDiag(diags, err)
@@ -788,7 +1714,9 @@ func resourceFmcAccessRulesDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 
 	err := c.DeleteFmcAccessRule(ctx, d.Get("acp").(string), d.Id())
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("access rule", err))
+	} else if err != nil {
 		return returnWithDiag(diags, err)
 	}
 
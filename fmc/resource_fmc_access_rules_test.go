@@ -0,0 +1,245 @@
+package fmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestExpandAccessRuleMatchCriteria(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFmcAccessRules().Schema, map[string]interface{}{
+		"urls": []interface{}{map[string]interface{}{
+			"literal":  []interface{}{"example.com"},
+			"category": []interface{}{map[string]interface{}{"id": "cat", "reputation": "trusted"}},
+		}},
+		"applications": []interface{}{map[string]interface{}{
+			"application": []interface{}{map[string]interface{}{"id": "app", "type": "Application"}},
+		}},
+		"vlan_tags": []interface{}{map[string]interface{}{
+			"literal": []interface{}{map[string]interface{}{"start_tag": 10}},
+		}},
+	})
+
+	sourceSecurityGroupTags, applications, users, vlanTags := expandAccessRuleMatchCriteria(d)
+	rule := &AccessRule{
+		Urls:                    expandAccessRuleURLs(d),
+		Sourcesecuritygrouptags: sourceSecurityGroupTags,
+		Applications:            applications,
+		Users:                   users,
+		Vlantags:                vlanTags,
+	}
+	body, err := json.Marshal(rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, expected := range map[string]string{
+		"urls":         `{"objects":null,"literals":[{"type":"Url","url":"example.com"}],"urlCategoriesWithReputation":[{"type":"UrlCategoryAndReputation","category":{"id":"cat","type":"URLCategory"},"reputation":"TRUSTED"}]}`,
+		"applications": `{"applications":[{"id":"app","type":"Application"}]}`,
+		"vlanTags":     `{"literals":[{"type":"VlanTagLiteral","startTag":10,"endTag":10}]}`,
+	} {
+		if string(payload[key]) != expected {
+			t.Errorf("expected %s to be %s, got %s", key, expected, payload[key])
+		}
+	}
+	for _, key := range []string{"sourceSecurityGroupTags", "users"} {
+		if _, ok := payload[key]; ok {
+			t.Errorf("expected %s not to be sent, got %s", key, payload[key])
+		}
+	}
+}
+
+func TestExpandAccessRuleLiterals(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFmcAccessRules().Schema, map[string]interface{}{
+		"source_networks": []interface{}{map[string]interface{}{
+			"literal": []interface{}{"10.0.0.1", "10.0.0.0/8", "2001:db8::1-2001:db8::9"},
+		}},
+		"destination_networks": []interface{}{map[string]interface{}{
+			"literal": []interface{}{"any"},
+		}},
+		"destination_ports": []interface{}{map[string]interface{}{
+			"literal": []interface{}{
+				map[string]interface{}{"protocol": "tcp", "port": "443"},
+				map[string]interface{}{"protocol": "47"},
+				map[string]interface{}{"protocol": "UDP", "port": "any"},
+			},
+		}},
+	})
+
+	body, err := json.Marshal(&AccessRule{
+		Sourcenetworks:      expandAccessRuleNetworks(d, "source_networks"),
+		Destinationnetworks: expandAccessRuleNetworks(d, "destination_networks"),
+		Destinationports:    expandAccessRulePorts(d, "destination_ports"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, expected := range map[string]string{
+		"sourceNetworks":      `{"objects":null,"literals":[{"type":"Host","value":"10.0.0.1"},{"type":"Network","value":"10.0.0.0/8"},{"type":"Range","value":"2001:db8::1-2001:db8::9"}]}`,
+		"destinationNetworks": `{"objects":null,"literals":[{"type":"Network","value":"0.0.0.0/0"},{"type":"Network","value":"::/0"}]}`,
+		"destinationPorts":    `{"objects":null,"literals":[{"type":"PortLiteral","protocol":"6","port":"443"},{"type":"PortLiteral","protocol":"47"},{"type":"PortLiteral","protocol":"17"}]}`,
+	} {
+		if string(payload[key]) != expected {
+			t.Errorf("expected %s to be %s, got %s", key, expected, payload[key])
+		}
+	}
+
+	if name := accessRulePortProtocolName("17"); name != "UDP" {
+		t.Errorf("expected UDP, got %s", name)
+	}
+}
+
+func TestExpandAccessRuleComments(t *testing.T) {
+	c := &Client{defaultCommentTemplate: "{operation} of {name} by terraform"}
+	for _, test := range []struct {
+		comments []interface{}
+		expected []string
+	}{
+		{nil, []string{"update of web by terraform"}},
+		{[]interface{}{"Ticket 42"}, []string{"Ticket 42"}},
+	} {
+		d := schema.TestResourceDataRaw(t, resourceFmcAccessRules().Schema, map[string]interface{}{
+			"name":         "web",
+			"new_comments": test.comments,
+		})
+		if comments := expandAccessRuleComments(c, d, "update"); !reflect.DeepEqual(comments, test.expected) {
+			t.Errorf("expected comments %v, got %v", test.expected, comments)
+		}
+	}
+}
+
+func TestCollapseAnyNetworkLiterals(t *testing.T) {
+	for _, test := range []struct {
+		literals, configured, expected []interface{}
+	}{
+		{[]interface{}{"10.0.0.0/8", "0.0.0.0/0", "::/0"}, []interface{}{"10.0.0.0/8", "any"}, []interface{}{"10.0.0.0/8", "any"}},
+		{[]interface{}{"0.0.0.0/0", "::/0"}, []interface{}{"any-ipv6", "0.0.0.0/0"}, []interface{}{"0.0.0.0/0", "any-ipv6"}},
+		{[]interface{}{"0.0.0.0/0", "::/0"}, []interface{}{"0.0.0.0/0", "::/0"}, []interface{}{"0.0.0.0/0", "::/0"}},
+		// Missing networks, e.g. removed in the FMC UI, are not collapsed so that the drift shows
+		{[]interface{}{"0.0.0.0/0"}, []interface{}{"any"}, []interface{}{"0.0.0.0/0"}},
+	} {
+		if collapsed := collapseAnyNetworkLiterals(test.literals, test.configured); !reflect.DeepEqual(collapsed, test.expected) {
+			t.Errorf("expected %v, got %v", test.expected, collapsed)
+		}
+	}
+}
+
+func TestValidateAccessRuleZoneType(t *testing.T) {
+	for zoneType, valid := range map[string]bool{
+		"SecurityZone":   true,
+		"InterfaceGroup": true,
+		"TunnelTag":      true,
+		"Network":        false,
+		"securityzone":   false,
+	} {
+		if _, errs := validateAccessRuleZoneType(zoneType, "type"); (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got errors %v", zoneType, valid, errs)
+		}
+	}
+}
+
+func TestValidateAccessRuleNetworkLiteral(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"10.0.0.1":                true,
+		"10.0.0.0/8":              true,
+		"2001:db8::1-2001:db8::9": true,
+		"Any-IPv4":                true,
+		"10.0.0.9-10.0.0.1":       false,
+		"10.0.0.1-2001:db8::1":    false,
+		"example.com":             false,
+	} {
+		if _, errs := validateAccessRuleNetworkLiteral(value, "literal"); (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got errors %v", value, valid, errs)
+		}
+	}
+}
+
+func TestSuppressDefaultVlanEndTag(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFmcAccessRules().Schema, map[string]interface{}{
+		"vlan_tags": []interface{}{map[string]interface{}{
+			"literal": []interface{}{map[string]interface{}{"start_tag": 10}},
+		}},
+	})
+	for _, tc := range []struct {
+		old, new string
+		suppress bool
+	}{
+		// FMC returns the start tag as the end of a single tag
+		{"10", "0", true},
+		{"20", "0", false},
+		{"10", "20", false},
+	} {
+		if suppress := suppressDefaultVlanEndTag("vlan_tags.0.literal.0.end_tag", tc.old, tc.new, d); suppress != tc.suppress {
+			t.Errorf("%s to %s: expected suppress %t, got %t", tc.old, tc.new, tc.suppress, suppress)
+		}
+	}
+}
+
+func TestAccessRulesCustomizeDiffTimezone(t *testing.T) {
+	for _, tc := range []struct {
+		validate bool
+		timezone string
+		requests int
+		err      string
+	}{
+		// The time range is only looked up with validate_references
+		{false, "", 0, ""},
+		{true, `{"id": "tz", "type": "TimeZone"}`, 3, ""},
+		{true, "null", 3, "devices ftd1 of access policy acp have no timezone object"},
+	} {
+		requests := 0
+		c, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/object/timeranges/tr"):
+				_, _ = w.Write([]byte(`{"id": "tr", "name": "Office hours"}`))
+			case strings.HasSuffix(r.URL.Path, "/assignment/policyassignments"):
+				_, _ = w.Write([]byte(`{"items": [
+					{"policy": {"id": "acp", "type": "AccessPolicy"}, "targets": [{"id": "1", "name": "ftd1", "type": "Device"}]},
+					{"policy": {"id": "ps", "type": "FTDPlatformSettingsPolicy"}, "targets": [{"id": "1", "type": "Device"}]}
+				], "paging": {"count": 2}}`))
+			case strings.HasSuffix(r.URL.Path, "/policy/ftdplatformsettingspolicies/ps/timezonesettings"):
+				fmt.Fprintf(w, `{"items": [{"id": "tzs", "type": "TimeZoneSetting", "timeZoneObject": %s}]}`, tc.timezone)
+			default:
+				t.Errorf("unexpected request %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		_, err := resourceFmcAccessRules().SimpleDiff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"acp":                 "acp",
+			"name":                "Office",
+			"action":              "ALLOW",
+			"enabled":             true,
+			"time_range":          "tr",
+			"validate_references": tc.validate,
+		}), c)
+		server.Close()
+
+		if tc.err == "" && err != nil {
+			t.Errorf("expected no error, got %v", err)
+		} else if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("expected %q, got %v", tc.err, err)
+		}
+		if requests != tc.requests {
+			t.Errorf("expected %d requests, got %d", tc.requests, requests)
+		}
+	}
+}
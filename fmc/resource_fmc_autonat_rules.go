@@ -75,11 +75,15 @@ func resourceFmcAutoNatRules() *schema.Resource {
 			"    ipv6 = true\n" +
 			"}\n" +
 			"```\n" +
-			"**Note** If creating multiple rules during a single `terraform apply`, remember to use `depends_on` to chain the rules so that terraform creates it in the same order that you intended.",
+			"**Note** If creating multiple rules during a single `terraform apply`, remember to use `depends_on` to chain the rules so that terraform creates it in the same order that you intended.\n" +
+			"\n" +
+			"**Note** With `identity_nat = true`, the translated network and port are the original ones, e.g. to exempt VPN traffic from NAT. " +
+			"Consider setting `no_proxy_arp` and `perform_route_lookup` along with it.",
 		CreateContext: resourceFmcAutoNatRulesCreate,
 		ReadContext:   resourceFmcAutoNatRulesRead,
 		UpdateContext: resourceFmcAutoNatRulesUpdate,
 		DeleteContext: resourceFmcAutoNatRulesDelete,
+		CustomizeDiff: validateIdentityNat,
 		Schema: map[string]*schema.Schema{
 			"nat_policy": {
 				Type:        schema.TypeString,
@@ -104,13 +108,7 @@ func resourceFmcAutoNatRules() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"nat_type": {
 				Type:     schema.TypeString,
@@ -275,6 +273,12 @@ func resourceFmcAutoNatRules() *schema.Resource {
 				},
 				Description: "Translated port for this resource",
 			},
+			"identity_nat": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"translated_network", "translated_network_is_destination_interface", "translated_port", "pat_options"},
+				Description:   "Translate the original network and port to themselves, `nat_type` has to be \"static\"",
+			},
 			"fallthrough": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -407,9 +411,15 @@ func resourceFmcAutoNatRulesCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	translatedPort := d.Get("translated_port").(int)
+	if d.Get("identity_nat").(bool) {
+		// Identity NAT translates the original network and port to themselves
+		translatedNetwork = originalNetwork
+		translatedPort = original_port["port"].(int)
+	}
 	res, err := c.CreateFmcAutoNatRule(ctx, d.Get("nat_policy").(string), &AutoNatRule{
 		Type:                         autonat_rules_type,
-		Description:                  d.Get("description").(string),
+		Description:                  c.withDescriptionMarker(d),
 		Nattype:                      strings.ToUpper(d.Get("nat_type").(string)),
 		Sourceinterface:              sourceInterface,
 		Destinationinterface:         destinationInterface,
@@ -418,7 +428,7 @@ func resourceFmcAutoNatRulesCreate(ctx context.Context, d *schema.ResourceData,
 		Interfaceintranslatednetwork: d.Get("translated_network_is_destination_interface").(bool),
 		Originalport:                 original_port["port"].(int),
 		Serviceprotocol:              strings.ToUpper(original_port["protocol"].(string)),
-		Translatedport:               d.Get("translated_port").(int),
+		Translatedport:               translatedPort,
 		Fallthrough:                  d.Get("fallthrough").(bool),
 		DNS:                          d.Get("translate_dns").(bool),
 		Noproxyarp:                   d.Get("no_proxy_arp").(bool),
@@ -473,7 +483,9 @@ func resourceFmcAutoNatRulesRead(ctx context.Context, d *schema.ResourceData, m
 			return returnWithDiag(diags, err)
 		}
 	}
-	if item.Translatednetwork != (AutoNatRuleSubConfig{}) {
+	// The translated network and port of identity NAT are not configured
+	identityNat := d.Get("identity_nat").(bool)
+	if item.Translatednetwork != (AutoNatRuleSubConfig{}) && !identityNat {
 		if err := d.Set("translated_network", convertTo1ListMapStringGeneric(item.Translatednetwork)); err != nil {
 			return returnWithDiag(diags, err)
 		}
@@ -491,8 +503,10 @@ func resourceFmcAutoNatRulesRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	if err := d.Set("translated_port", item.Translatedport); err != nil {
-		return returnWithDiag(diags, err)
+	if !identityNat {
+		if err := d.Set("translated_port", item.Translatedport); err != nil {
+			return returnWithDiag(diags, err)
+		}
 	}
 
 	if err := d.Set("fallthrough", item.Fallthrough); err != nil {
@@ -533,7 +547,7 @@ func resourceFmcAutoNatRulesUpdate(ctx context.Context, d *schema.ResourceData,
 	// Warning or errors can be collected in a slice type
 	// var diags diag.Diagnostics
 	var diags diag.Diagnostics
-	if d.HasChanges("type", "description", "nat_type", "source_interface", "destination_interface", "original_network", "translated_network", "translated_network_is_destination_interface", "original_port", "translated_port", "fallthrough", "translate_dns", "no_proxy_arp", "perform_route_lookup", "net_to_net", "ipv6", "pat_options") {
+	if d.HasChanges("type", "description", "nat_type", "source_interface", "destination_interface", "original_network", "translated_network", "translated_network_is_destination_interface", "original_port", "translated_port", "identity_nat", "fallthrough", "translate_dns", "no_proxy_arp", "perform_route_lookup", "net_to_net", "ipv6", "pat_options") {
 		var sourceInterface, destinationInterface, originalNetwork, translatedNetwork *AutoNatRuleSubConfig
 		dynamicObjects := []**AutoNatRuleSubConfig{
 			&sourceInterface, &destinationInterface, &originalNetwork, &translatedNetwork,
@@ -576,10 +590,16 @@ func resourceFmcAutoNatRulesUpdate(ctx context.Context, d *schema.ResourceData,
 				Roundrobin:     pat_options["round_robin"].(bool),
 			}
 		}
+		translatedPort := d.Get("translated_port").(int)
+		if d.Get("identity_nat").(bool) {
+			// Identity NAT translates the original network and port to themselves
+			translatedNetwork = originalNetwork
+			translatedPort = original_port["port"].(int)
+		}
 		res, err := c.UpdateFmcAutoNatRule(ctx, d.Get("nat_policy").(string), d.Id(), &AutoNatRule{
 			ID:                           d.Id(),
 			Type:                         autonat_rules_type,
-			Description:                  d.Get("description").(string),
+			Description:                  c.withDescriptionMarker(d),
 			Nattype:                      strings.ToUpper(d.Get("nat_type").(string)),
 			Sourceinterface:              sourceInterface,
 			Destinationinterface:         destinationInterface,
@@ -588,7 +608,7 @@ func resourceFmcAutoNatRulesUpdate(ctx context.Context, d *schema.ResourceData,
 			Interfaceintranslatednetwork: d.Get("translated_network_is_destination_interface").(bool),
 			Originalport:                 original_port["port"].(int),
 			Serviceprotocol:              strings.ToUpper(original_port["protocol"].(string)),
-			Translatedport:               d.Get("translated_port").(int),
+			Translatedport:               translatedPort,
 			Fallthrough:                  d.Get("fallthrough").(bool),
 			DNS:                          d.Get("translate_dns").(bool),
 			Noproxyarp:                   d.Get("no_proxy_arp").(bool),
@@ -612,7 +632,9 @@ func resourceFmcAutoNatRulesDelete(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 
 	err := c.DeleteFmcAutoNatRule(ctx, d.Get("nat_policy").(string), d.Id())
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("auto nat rule", err))
+	} else if err != nil {
 		return returnWithDiag(diags, err)
 	}
 
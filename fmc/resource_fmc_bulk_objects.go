@@ -0,0 +1,396 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The bulk endpoints of the objects were added in FMC 6.4
+var bulk_object_minimum_version string = "6.4"
+
+// The kinds of values of the objects which can be created in bulk, by type
+var bulk_object_value_kinds = map[string]string{
+	"Network": ip_value_network,
+	"Host":    ip_value_host,
+	"Range":   ip_value_range,
+}
+
+func resourceFmcBulkObjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for a set of related Network, Host or Range Objects created together in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_bulk_objects\" \"web_servers\" {\n" +
+			"    type = \"Host\"\n" +
+			"    objects {\n" +
+			"        name = \"web-1\"\n" +
+			"        value = \"10.10.10.11\"\n" +
+			"    }\n" +
+			"    objects {\n" +
+			"        name = \"web-2\"\n" +
+			"        value = \"10.10.10.12\"\n" +
+			"    }\n" +
+			"}\n" +
+			"\n" +
+			"resource \"fmc_network_group_objects\" \"web_servers\" {\n" +
+			"    name = \"web-servers\"\n" +
+			"    dynamic \"objects\" {\n" +
+			"        for_each = fmc_bulk_objects.web_servers.ids\n" +
+			"        content {\n" +
+			"            id = objects.value\n" +
+			"            type = fmc_bulk_objects.web_servers.type\n" +
+			"        }\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The objects added to the set are created with one bulk request, FMC validates all of them first, " +
+			"so either all of them are created or none, e.g. a duplicate name does not leave a half-built group behind. " +
+			"Changes to existing objects and deletions are sent per object, if one of them fails the objects changed so far are kept in the state.\n" +
+			"\n" +
+			"**Note** The objects are identified by name, renaming an object deletes it and creates a new one. Requires FMC 6.4 or later.",
+		CreateContext: resourceFmcBulkObjectsCreate,
+		ReadContext:   resourceFmcBulkObjectsRead,
+		UpdateContext: resourceFmcBulkObjectsUpdate,
+		DeleteContext: resourceFmcBulkObjectsDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if err := m.(*Client).requireFmcVersion(ctx, "fmc_bulk_objects", bulk_object_minimum_version); err != nil {
+				return err
+			}
+			kind := bulk_object_value_kinds[d.Get("type").(string)]
+			names := map[string]bool{}
+			for i, obj := range d.Get("objects").([]interface{}) {
+				obji := obj.(map[string]interface{})
+				name, value := obji["name"].(string), obji["value"].(string)
+				// Unknown until apply
+				if name == "" || value == "" {
+					continue
+				}
+				if names[name] {
+					return fmt.Errorf("objects.%d: the name %q is used more than once", i, name)
+				}
+				names[name] = true
+				if _, errs := validateIPValue(kind)(value, fmt.Sprintf("objects.%d.value", i)); len(errs) > 0 {
+					return errs[0]
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if _, ok := bulk_object_paths[v]; !ok {
+						errs = append(errs, fmt.Errorf(`%q must be "Network", "Host" or "Range", got: %q`, key, v))
+					}
+					return
+				},
+				Description: `The type of the objects, "Network", "Host" or "Range"`,
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateFmcName(fmc_object_name_max_length),
+							Description:  "The name of the object",
+						},
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressEquivalentIPValues,
+							Description:      "The network, address or range of the object, depending on the type",
+						},
+						"description": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressDescriptionMarker,
+							Description:      "The description of the object",
+						},
+						"overridable": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the value of the object can be overridden per device or domain, defaults to false",
+						},
+					},
+				},
+				Description: "The objects of this resource",
+			},
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the objects keyed by name",
+			},
+		},
+	}
+}
+
+// expandBulkObjects returns the objects of the configuration keyed by name, and their names in order
+func expandBulkObjects(d *schema.ResourceData, objects []interface{}) (map[string]*BulkObject, []string) {
+	expanded := map[string]*BulkObject{}
+	names := []string{}
+	for _, obj := range objects {
+		obji := obj.(map[string]interface{})
+		name := obji["name"].(string)
+		expanded[name] = &BulkObject{
+			Name:        name,
+			Value:       obji["value"].(string),
+			Description: obji["description"].(string),
+			Overridable: obji["overridable"].(bool),
+			Type:        d.Get("type").(string),
+		}
+		names = append(names, name)
+	}
+	return expanded, names
+}
+
+func bulkObjectIDs(d *schema.ResourceData) map[string]interface{} {
+	ids := map[string]interface{}{}
+	for name, id := range d.Get("ids").(map[string]interface{}) {
+		ids[name] = id
+	}
+	return ids
+}
+
+func resourceFmcBulkObjectsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	if err := c.requireFmcVersion(ctx, "fmc_bulk_objects", bulk_object_minimum_version); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create objects",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	objects, names := expandBulkObjects(d, d.Get("objects").([]interface{}))
+	create := make([]*BulkObject, 0, len(names))
+	for _, name := range names {
+		create = append(create, objects[name])
+	}
+	created, err := c.CreateFmcBulkObjects(ctx, d.Get("type").(string), create)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create objects",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	ids := map[string]interface{}{}
+	for name, obj := range created {
+		ids[name] = obj.ID
+	}
+	if err := d.Set("ids", ids); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create objects",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	// The set has no ID in FMC, the ID of its first object identifies it
+	first, ok := created[names[0]]
+	if !ok {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create objects",
+			Detail:   fmt.Sprintf("object %s was not returned by FMC", names[0]),
+		})
+		return diags
+	}
+	d.SetId(first.ID)
+	return resourceFmcBulkObjectsRead(ctx, d, m)
+}
+
+func resourceFmcBulkObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ids := bulkObjectIDs(d)
+	// Keep the order of the objects in the state, the objects missing from it follow by name
+	names := []string{}
+	inState := map[string]bool{}
+	for _, obj := range d.Get("objects").([]interface{}) {
+		if name := obj.(map[string]interface{})["name"].(string); ids[name] != nil {
+			names = append(names, name)
+			inState[name] = true
+		}
+	}
+	others := []string{}
+	for name := range ids {
+		if !inState[name] {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+	names = append(names, others...)
+
+	objects := []interface{}{}
+	for _, name := range names {
+		item, err := c.GetFmcBulkObject(ctx, d.Get("type").(string), ids[name].(string))
+		if isNotFound(err) {
+			// Deleted outside of terraform, it is created again on the next apply
+			delete(ids, name)
+			continue
+		}
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read objects",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		// The ID keeps the name the object was created with
+		delete(ids, name)
+		ids[item.Name] = item.ID
+		objects = append(objects, map[string]interface{}{
+			"name":        item.Name,
+			"value":       item.Value,
+			"description": item.Description,
+			"overridable": item.Overridable,
+		})
+	}
+	if len(ids) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	for key, value := range map[string]interface{}{
+		"objects": objects,
+		"ids":     ids,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read objects",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcBulkObjectsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChange("objects") {
+		objectType := d.Get("type").(string)
+		o, n := d.GetChange("objects")
+		oldObjects, _ := expandBulkObjects(d, o.([]interface{}))
+		newObjects, names := expandBulkObjects(d, n.([]interface{}))
+		ids := bulkObjectIDs(d)
+
+		// On an error only the IDs are saved, so the next read shows the objects as they are in FMC
+		fail := func(err error) diag.Diagnostics {
+			d.Partial(true)
+			_ = d.Set("ids", ids)
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update objects",
+				Detail:   err.Error(),
+			})
+		}
+
+		create := []*BulkObject{}
+		for _, name := range names {
+			obj := newObjects[name]
+			old, ok := oldObjects[name]
+			if !ok || ids[name] == nil {
+				create = append(create, obj)
+				continue
+			}
+			if *obj == *old {
+				continue
+			}
+			obj.ID = ids[name].(string)
+			if _, err := c.UpdateFmcBulkObject(ctx, objectType, obj.ID, obj); err != nil {
+				return fail(err)
+			}
+		}
+		if len(create) > 0 {
+			created, err := c.CreateFmcBulkObjects(ctx, objectType, create)
+			if err != nil {
+				return fail(err)
+			}
+			for name, obj := range created {
+				ids[name] = obj.ID
+			}
+		}
+		for name := range oldObjects {
+			if _, ok := newObjects[name]; ok || ids[name] == nil {
+				continue
+			}
+			err := c.DeleteFmcBulkObject(ctx, objectType, ids[name].(string))
+			if err != nil && !isNotFound(err) {
+				return fail(err)
+			}
+			delete(ids, name)
+		}
+		if err := d.Set("ids", ids); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update objects",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcBulkObjectsRead(ctx, d, m)
+}
+
+func resourceFmcBulkObjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ids := bulkObjectIDs(d)
+	for name, id := range ids {
+		err := c.DeleteFmcBulkObject(ctx, d.Get("type").(string), id.(string))
+		if isNotFound(err) {
+			diags = append(diags, alreadyDeleted("object "+name, err))
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to delete objects",
+				Detail:   err.Error(),
+			})
+			// Keep the objects which are left
+			d.Partial(true)
+			_ = d.Set("ids", ids)
+			return diags
+		}
+		delete(ids, name)
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
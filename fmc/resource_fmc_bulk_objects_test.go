@@ -0,0 +1,76 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcBulkObjectsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcBulkObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcBulkObjectsConfigBasic([]string{"test_bulk_1", "test_bulk_2"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fmc_bulk_objects.test", "objects.#", "2"),
+					resource.TestCheckResourceAttrSet("fmc_bulk_objects.test", "ids.test_bulk_2"),
+				),
+			},
+			{
+				Config: testAccCheckFmcBulkObjectsConfigBasic([]string{"test_bulk_2", "test_bulk_3"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fmc_bulk_objects.test", "objects.#", "2"),
+					resource.TestCheckNoResourceAttr("fmc_bulk_objects.test", "ids.test_bulk_1"),
+					resource.TestCheckResourceAttrSet("fmc_bulk_objects.test", "ids.test_bulk_3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcBulkObjectsDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_bulk_objects" {
+			continue
+		}
+
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "ids.") || key == "ids.%" {
+				continue
+			}
+			err := c.DeleteFmcBulkObject(context.Background(), rs.Primary.Attributes["type"], id)
+
+			// Object is already deleted
+			if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcBulkObjectsConfigBasic(names []string) string {
+	objects := ""
+	for i, name := range names {
+		objects += fmt.Sprintf(`
+        objects {
+            name  = "%s"
+            value = "10.10.10.%d"
+        }`, name, i+11)
+	}
+	return fmt.Sprintf(`
+    resource "fmc_bulk_objects" "test" {
+        type = "Host"%s
+    }
+    `, objects)
+}
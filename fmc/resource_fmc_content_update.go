@@ -0,0 +1,194 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFmcContentUpdate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for updating the VDB, intrusion rules (SRU) and geolocation database in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_content_update\" \"vdb\" {\n" +
+			"    update_type = \"VDB\"\n" +
+			"    triggers = {\n" +
+			"        window = var.maintenance_window\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The latest update is downloaded from the Cisco cloud and installed on create and whenever `triggers` change, " +
+			"FMC needs internet access for it. Installing a VDB update may restart Snort on the devices once the policies are deployed. " +
+			"Destroying this resource does not roll back the update.",
+		CreateContext: resourceFmcContentUpdateCreate,
+		ReadContext:   resourceFmcContentUpdateRead,
+		DeleteContext: resourceFmcContentUpdateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"update_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"VDB", "SRU", "GEODB"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				Description: `The content to update, "VDB", "SRU" or "GEODB"`,
+			},
+			"install": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Install the update after downloading it, true by default",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values, changing them updates the content again",
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the task which updated the content",
+			},
+			"installed_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the content installed in FMC",
+			},
+		},
+	}
+}
+
+// installedContentVersion returns the version of the content of the given update type
+func installedContentVersion(serverVersion *ServerVersion, updateType string) string {
+	switch strings.ToUpper(updateType) {
+	case "VDB":
+		return serverVersion.Vdbversion
+	case "SRU":
+		return serverVersion.Sruversion
+	case "GEODB":
+		return serverVersion.Geoversion
+	}
+	return ""
+}
+
+func resourceFmcContentUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	updateType := strings.ToUpper(d.Get("update_type").(string))
+	res, err := c.CreateFmcContentUpdate(ctx, &ContentUpdate{
+		Type:    updateType,
+		Install: d.Get("install").(bool),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update content",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	taskID := res.Metadata.Task.ID
+
+	// The task covers both the download and the install
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		task, err := c.GetFmcTaskStatus(ctx, taskID)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		switch strings.ToUpper(task.Status) {
+		case "FAILED":
+			return resource.NonRetryableError(fmt.Errorf("updating %s failed: %s", updateType, task.Message))
+		case "SUCCESS", "COMPLETED":
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("%s is not updated yet, task status: %s", updateType, task.Status))
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update content",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(taskID)
+	if err := d.Set("task_id", taskID); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	return resourceFmcContentUpdateRead(ctx, d, m)
+}
+
+func resourceFmcContentUpdateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	serverVersion, err := c.GetFmcServerVersion(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read content update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("installed_version", installedContentVersion(serverVersion, d.Get("update_type").(string))); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read content update",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceFmcContentUpdateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Content updates cannot be rolled back through the API, so only remove this resource from the state
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,60 @@
+package fmc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcContentUpdateBasic(t *testing.T) {
+	updateType := "GEODB"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcContentUpdateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcContentUpdateConfigBasic(updateType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcContentUpdateExists("fmc_content_update.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcContentUpdateDestroy(s *terraform.State) error {
+	// Content updates stay installed in FMC, there is nothing to check
+	return nil
+}
+
+func testAccCheckFmcContentUpdateConfigBasic(updateType string) string {
+	return fmt.Sprintf(`
+    resource "fmc_content_update" "test" {
+        update_type = "%s"
+    }
+    `, updateType)
+}
+
+func testAccCheckFmcContentUpdateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		if rs.Primary.Attributes["installed_version"] == "" {
+			return fmt.Errorf("no installed version read")
+		}
+
+		return nil
+	}
+}
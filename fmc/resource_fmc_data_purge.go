@@ -0,0 +1,183 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The minimum length of the name prefix, so that a short prefix cannot purge most of a domain by mistake
+var data_purge_min_prefix_length = 3
+
+func resourceFmcDataPurge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for deleting all the objects whose names start with a prefix in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_data_purge\" \"ci\" {\n" +
+			"    name_prefix = \"ci-${var.run_id}-\"\n" +
+			"    confirm = true\n" +
+			"    triggers = {\n" +
+			"        run = var.run_id\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Warning** This resource is DESTRUCTIVE, it deletes every object of the `object_types` whose name starts with " +
+			"`name_prefix` in the domain, whether or not terraform created it. It is meant for cleaning up ephemeral test domains, " +
+			"e.g. between CI runs, never use it in a production domain. The objects are deleted on create and whenever `triggers` " +
+			"change, destroying this resource does not restore them. Objects which are still in use, e.g. by rules, cannot be " +
+			"deleted and are reported as warnings.",
+		CreateContext: resourceFmcDataPurgeCreate,
+		ReadContext:   resourceFmcDataPurgeRead,
+		DeleteContext: resourceFmcDataPurgeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.TrimSpace(val.(string))
+					if len(v) < data_purge_min_prefix_length {
+						errs = append(errs, fmt.Errorf("%q must be at least %d characters long, got: %q", key, data_purge_min_prefix_length, v))
+					}
+					return
+				},
+				Description: fmt.Sprintf("The objects whose names start with this prefix are deleted, at least %d characters long", data_purge_min_prefix_length),
+			},
+			"object_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						v := val.(string)
+						for _, allowed := range data_purge_object_types {
+							if v == allowed {
+								return
+							}
+						}
+						errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, data_purge_object_types, v))
+						return
+					},
+				},
+				Description: fmt.Sprintf("The types of the objects to delete, %v by default", data_purge_object_types),
+			},
+			"confirm": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if !val.(bool) {
+						errs = append(errs, fmt.Errorf("%q must be true to delete the objects", key))
+					}
+					return
+				},
+				Description: "Must be set to true, to acknowledge that the matching objects are deleted",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values, changing them deletes the matching objects again",
+			},
+			"deleted_objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The deleted objects, as \"type/name\"",
+			},
+		},
+	}
+}
+
+// dataPurgeObjectTypes returns the object types to purge in the order they are deleted
+func dataPurgeObjectTypes(d *schema.ResourceData) []string {
+	selected := d.Get("object_types").(*schema.Set)
+	if selected.Len() == 0 {
+		return data_purge_object_types
+	}
+	objectTypes := []string{}
+	for _, objectType := range data_purge_object_types {
+		if selected.Contains(objectType) {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	return objectTypes
+}
+
+func resourceFmcDataPurgeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	prefix := d.Get("name_prefix").(string)
+	deleted := []string{}
+	for _, objectType := range dataPurgeObjectTypes(d) {
+		objects, err := c.GetFmcObjectsByNamePrefix(ctx, objectType, prefix)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get objects to purge",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		for _, object := range objects {
+			err := c.DeleteFmcObject(ctx, objectType, object.ID)
+			if err != nil && !isNotFound(err) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("unable to purge %s %s", objectType, object.Name),
+					Detail:   err.Error(),
+				})
+				continue
+			}
+			deleted = append(deleted, fmt.Sprintf("%s/%s", objectType, object.Name))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("Purge of objects named %s*", prefix))
+	if err := d.Set("deleted_objects", deleted); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	return diags
+}
+
+func resourceFmcDataPurgeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	// The purge happened on create, there is nothing to read back
+	return diags
+}
+
+func resourceFmcDataPurgeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Deleted objects cannot be restored, so only remove this resource from the state
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,198 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var device_group_type string = "DeviceGroup"
+
+func resourceFmcDeviceGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for Device Groups in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_device_groups\" \"branches\" {\n" +
+			"    name = \"Branches\"\n" +
+			"    members {\n" +
+			"        id = data.fmc_devices.branch1.id\n" +
+			"        type = data.fmc_devices.branch1.type\n" +
+			"    }\n" +
+			"    members {\n" +
+			"        id = data.fmc_devices.branch2.id\n" +
+			"        type = data.fmc_devices.branch2.type\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** A device can only be a member of one device group. The groups can be targeted by " +
+			"`fmc_policy_devices_assignments` with `target_device_groups` and deployed by `fmc_staged_changes` with `device_groups`.",
+		CreateContext: resourceFmcDeviceGroupsCreate,
+		ReadContext:   resourceFmcDeviceGroupsRead,
+		UpdateContext: resourceFmcDeviceGroupsUpdate,
+		DeleteContext: resourceFmcDeviceGroupsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the device",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The type of the device, e.g. "Device", "DeviceHAPair" or "DeviceCluster"`,
+						},
+					},
+				},
+				Description: "The devices in this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandDeviceGroupMembers(d *schema.ResourceData) []DeviceGroupMember {
+	members := []DeviceGroupMember{}
+	for _, obj := range d.Get("members").(*schema.Set).List() {
+		obji := obj.(map[string]interface{})
+		members = append(members, DeviceGroupMember{
+			ID:   obji["id"].(string),
+			Type: obji["type"].(string),
+		})
+	}
+	return members
+}
+
+func resourceFmcDeviceGroupsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	res, err := c.CreateFmcDeviceGroup(ctx, &DeviceGroup{
+		Name:    d.Get("name").(string),
+		Type:    device_group_type,
+		Members: expandDeviceGroupMembers(d),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create device group",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcDeviceGroupsRead(ctx, d, m)
+}
+
+func resourceFmcDeviceGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcDeviceGroup(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device group",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	members := make([]interface{}, 0, len(item.Members))
+	for _, member := range item.Members {
+		members = append(members, map[string]interface{}{
+			"id":   member.ID,
+			"type": member.Type,
+		})
+	}
+	for key, value := range map[string]interface{}{
+		"name":    item.Name,
+		"type":    item.Type,
+		"members": members,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read device group",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return diags
+}
+
+func resourceFmcDeviceGroupsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	if d.HasChanges("name", "members") {
+		_, err := c.UpdateFmcDeviceGroup(ctx, d.Id(), &DeviceGroup{
+			ID:      d.Id(),
+			Name:    d.Get("name").(string),
+			Type:    device_group_type,
+			Members: expandDeviceGroupMembers(d),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update device group",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcDeviceGroupsRead(ctx, d, m)
+}
+
+func resourceFmcDeviceGroupsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcDeviceGroup(ctx, d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("device group", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete device group",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,83 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcDeviceGroupsBasic(t *testing.T) {
+	name := "test_device_group"
+	device := "ftd.adyah.cisco"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcDeviceGroupsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcDeviceGroupsConfigBasic(name, device),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceGroupsExists("fmc_device_groups.test"),
+					resource.TestCheckResourceAttr("fmc_device_groups.test", "members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcDeviceGroupsDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_device_groups" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcDeviceGroup(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcDeviceGroupsConfigBasic(name, device string) string {
+	return fmt.Sprintf(`
+	data "fmc_devices" "device" {
+		name = "%s"
+	}
+	resource "fmc_device_groups" "test" {
+		name = "%s"
+		members {
+			id = data.fmc_devices.device.id
+			type = data.fmc_devices.device.type
+		}
+	}
+	`, device, name)
+}
+
+func testAccCheckFmcDeviceGroupsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		return nil
+	}
+}
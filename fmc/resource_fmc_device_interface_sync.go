@@ -0,0 +1,207 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFmcDeviceInterfaceSync() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for syncing the interfaces of FTD devices in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_device_interface_sync\" \"ftd\" {\n" +
+			"    device = data.fmc_devices.ftd.id\n" +
+			"    triggers = {\n" +
+			"        nics = var.ftd_nic_count\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The interfaces are synced on create and whenever `triggers` change, e.g. after adding NICs to an FTDv. " +
+			"Resources referencing the interfaces, e.g. `fmc_device_physical_interfaces`, should depend on this resource. " +
+			"Destroying this resource does not change the device.",
+		CreateContext: resourceFmcDeviceInterfaceSyncCreate,
+		ReadContext:   resourceFmcDeviceInterfaceSyncRead,
+		DeleteContext: resourceFmcDeviceInterfaceSyncDelete,
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD device",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values, changing them syncs the interfaces again",
+			},
+			"accept_changes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Accept the interface changes found on the device, otherwise they have to be accepted in the FMC UI",
+			},
+			"changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the changed interface",
+						},
+						"change_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The change of the interface, e.g. "ADDED" or "REMOVED"`,
+						},
+					},
+				},
+				Description: "The interface changes found on the device by the last sync",
+			},
+			"interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of this interface",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of this interface",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hardware name of this interface",
+						},
+						"ifname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The logical name of this interface",
+						},
+					},
+				},
+				Description: "The physical interfaces of the device",
+			},
+		},
+	}
+}
+
+func resourceFmcDeviceInterfaceSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	deviceID := d.Get("device").(string)
+	if err := c.UpdateFmcInterfaceEvents(ctx, deviceID, interface_events_sync); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to sync device interfaces",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	events, err := c.GetFmcInterfaceEvents(ctx, deviceID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to sync device interfaces",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	if len(events) != 0 && d.Get("accept_changes").(bool) {
+		if err := c.UpdateFmcInterfaceEvents(ctx, deviceID, interface_events_accept); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to accept device interface changes",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	changes := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		changes = append(changes, map[string]interface{}{
+			"name":        event.Name,
+			"change_type": event.Changetype,
+		})
+	}
+	d.SetId(deviceID)
+	if err := d.Set("changes", changes); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	return resourceFmcDeviceInterfaceSyncRead(ctx, d, m)
+}
+
+func resourceFmcDeviceInterfaceSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	items, err := c.GetFmcPhysicalInterfaces(ctx, d.Get("device").(string))
+	if err != nil {
+		// Device was deregistered, so there is nothing to sync anymore
+		if isNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device interfaces",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	interfaces := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		interfaces = append(interfaces, map[string]interface{}{
+			"id":     item.ID,
+			"type":   item.Type,
+			"name":   item.Name,
+			"ifname": item.Ifname,
+		})
+	}
+	if err := d.Set("interfaces", interfaces); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device interfaces",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceFmcDeviceInterfaceSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Synced interfaces cannot be unsynced, so only remove this resource from the state
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
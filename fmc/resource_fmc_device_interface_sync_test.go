@@ -0,0 +1,84 @@
+package fmc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcDeviceInterfaceSyncBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+	trigger := "1"
+	triggerUpdated := "2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcDeviceInterfaceSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcDeviceInterfaceSyncConfigBasic(device, trigger),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceInterfaceSyncExists("fmc_device_interface_sync.test", map[string]string{
+						"triggers.sync": trigger,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcDeviceInterfaceSyncConfigBasic(device, triggerUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceInterfaceSyncExists("fmc_device_interface_sync.test", map[string]string{
+						"triggers.sync": triggerUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcDeviceInterfaceSyncDestroy(s *terraform.State) error {
+	// Synced interfaces stay on the device, there is nothing to check
+	return nil
+}
+
+func testAccCheckFmcDeviceInterfaceSyncConfigBasic(device, trigger string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "test" {
+        name = "%s"
+    }
+    resource "fmc_device_interface_sync" "test" {
+        device = data.fmc_devices.test.id
+        triggers = {
+            sync = "%s"
+        }
+    }
+    `, device, trigger)
+}
+
+func testAccCheckFmcDeviceInterfaceSyncExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		if rs.Primary.Attributes["interfaces.#"] == "0" {
+			return fmt.Errorf("No interfaces found")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,185 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFmcDeviceLicenses() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for the License Capabilities of registered FTD Devices in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_device_licenses\" \"ftd\" {\n" +
+			"    device = data.fmc_devices.ftd.id\n" +
+			"    license_caps = [\"BASE\", \"THREAT\", \"MALWARE\", \"URLFilter\"]\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Do not set `license_caps` of `fmc_devices` for the same device, changing those registers the device again. " +
+			"On destroy, the licenses are left as they are, unless `cleanup_licenses` is true.",
+		CreateContext: resourceFmcDeviceLicensesCreate,
+		ReadContext:   resourceFmcDeviceLicensesRead,
+		UpdateContext: resourceFmcDeviceLicensesUpdate,
+		DeleteContext: resourceFmcDeviceLicensesDelete,
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD device this resource belongs to",
+			},
+			"license_caps": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						v := val.(string)
+						allowedValues := []string{"BASE", "THREAT", "MALWARE", "URLFilter", "CARRIER"}
+						for _, allowed := range allowedValues {
+							if v == allowed {
+								return
+							}
+						}
+						errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+						return
+					},
+				},
+				Description: `License capabilities of the device, "BASE", "THREAT", "MALWARE", "URLFilter" or "CARRIER"`,
+			},
+			"cleanup_licenses": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Release all the licenses of the device on destroy",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the FTD device",
+			},
+		},
+	}
+}
+
+func updateFmcDeviceLicenseCaps(ctx context.Context, c *Client, id string, licenseCaps []string) error {
+	device, err := c.GetFmcDevice(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = c.UpdateFmcDeviceLicenses(ctx, id, &DeviceLicenseUpdate{
+		ID:          id,
+		Type:        device_type,
+		Name:        device.Name,
+		Licensecaps: licenseCaps,
+	})
+	return err
+}
+
+func expandDeviceLicenseCaps(d *schema.ResourceData) []string {
+	licenseCaps := []string{}
+	for _, licenseCap := range d.Get("license_caps").(*schema.Set).List() {
+		licenseCaps = append(licenseCaps, licenseCap.(string))
+	}
+	return licenseCaps
+}
+
+func resourceFmcDeviceLicensesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	id := d.Get("device").(string)
+	if err := updateFmcDeviceLicenseCaps(ctx, c, id, expandDeviceLicenseCaps(d)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to update device licenses",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(id)
+	return resourceFmcDeviceLicensesRead(ctx, d, m)
+}
+
+func resourceFmcDeviceLicensesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcDevice(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device licenses",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	for key, value := range map[string]interface{}{
+		"device":       item.ID,
+		"name":         item.Name,
+		"license_caps": item.Licensecaps,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read device licenses",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcDeviceLicensesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChange("license_caps") {
+		if err := updateFmcDeviceLicenseCaps(ctx, c, d.Id(), expandDeviceLicenseCaps(d)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update device licenses",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcDeviceLicensesRead(ctx, d, m)
+}
+
+func resourceFmcDeviceLicensesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if d.Get("cleanup_licenses").(bool) {
+		err := updateFmcDeviceLicenseCaps(ctx, c, d.Id(), []string{})
+		// Device is already deregistered, so are its licenses
+		if err != nil && !isNotFound(err) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to release device licenses",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,80 @@
+package fmc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcDeviceLicensesBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+	licenseCaps := `["BASE", "THREAT"]`
+	licenseCapsUpdated := `["BASE", "THREAT", "MALWARE"]`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcDeviceLicensesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcDeviceLicensesConfigBasic(device, licenseCaps),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceLicensesExists("fmc_device_licenses.test", map[string]string{
+						"name":           device,
+						"license_caps.#": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcDeviceLicensesConfigBasic(device, licenseCapsUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceLicensesExists("fmc_device_licenses.test", map[string]string{
+						"name":           device,
+						"license_caps.#": "3",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// The licenses are left on the device on destroy, so there is nothing to check
+func testAccCheckFmcDeviceLicensesDestroy(s *terraform.State) error {
+	return nil
+}
+
+func testAccCheckFmcDeviceLicensesConfigBasic(device, licenseCaps string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "ftd" {
+        name = "%s"
+    }
+    resource "fmc_device_licenses" "test" {
+        device       = data.fmc_devices.ftd.id
+        license_caps = %s
+    }
+    `, device, licenseCaps)
+}
+
+func testAccCheckFmcDeviceLicensesExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
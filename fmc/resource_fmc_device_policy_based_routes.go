@@ -0,0 +1,335 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var policy_based_route_type string = "PolicyBasedRoute"
+
+// The endpoint of the policy based routes was added in FMC 7.1
+var policy_based_route_minimum_version string = "7.1"
+
+func resourceFmcDevicePolicyBasedRoutes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for Policy Based Routes on FTD devices in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_device_policy_based_routes\" \"pbr\" {\n" +
+			"    device = data.fmc_devices.ftd.id\n" +
+			"    ingress_interfaces {\n" +
+			"        id = data.fmc_device_physical_interfaces.inside.id\n" +
+			"        type = data.fmc_device_physical_interfaces.inside.type\n" +
+			"    }\n" +
+			"    forwarding_actions {\n" +
+			"        match_acl {\n" +
+			"            id = data.fmc_extended_access_lists.pbr_acl.id\n" +
+			"            type = data.fmc_extended_access_lists.pbr_acl.type\n" +
+			"        }\n" +
+			"        egress_interfaces {\n" +
+			"            id = data.fmc_device_physical_interfaces.isp1.id\n" +
+			"            type = data.fmc_device_physical_interfaces.isp1.type\n" +
+			"        }\n" +
+			"        egress_interfaces {\n" +
+			"            id = data.fmc_device_physical_interfaces.isp2.id\n" +
+			"            type = data.fmc_device_physical_interfaces.isp2.type\n" +
+			"        }\n" +
+			"        interface_ordering = \"ORDER\"\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Policy based routes are supported natively from FMC 7.1 onwards, use FlexConfig for older versions. " +
+			"On older versions the plan fails with the version of FMC.",
+		CreateContext: resourceFmcDevicePolicyBasedRoutesCreate,
+		ReadContext:   resourceFmcDevicePolicyBasedRoutesRead,
+		UpdateContext: resourceFmcDevicePolicyBasedRoutesUpdate,
+		DeleteContext: resourceFmcDevicePolicyBasedRoutesDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return m.(*Client).requireFmcVersion(ctx, "fmc_device_policy_based_routes", policy_based_route_minimum_version)
+		},
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD device this resource belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of this resource",
+			},
+			"ingress_interfaces": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of this resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of this resource",
+						},
+					},
+				},
+				Description: "Interfaces on which the traffic is matched",
+			},
+			"forwarding_actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"match_acl": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+							Description: "Extended access list matching the traffic to forward",
+						},
+						"egress_interfaces": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+							Description: "Interfaces the matched traffic is forwarded to, in order",
+						},
+						"interface_ordering": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "INTERFACE_PRIORITY",
+							StateFunc: func(val interface{}) string {
+								return strings.ToUpper(val.(string))
+							},
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := strings.ToUpper(val.(string))
+								allowedValues := []string{"INTERFACE_PRIORITY", "ORDER"}
+								for _, allowed := range allowedValues {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+								return
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+							Description: `How the egress interface is chosen, "INTERFACE_PRIORITY" uses the priority configured on the interfaces and "ORDER" uses the order of egress_interfaces`,
+						},
+					},
+				},
+				Description: "Forwarding actions of this resource, evaluated in order",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandPolicyBasedRouteSubConfigs(objs []interface{}) []PolicyBasedRouteSubConfig {
+	subConfigs := []PolicyBasedRouteSubConfig{}
+	for _, obj := range objs {
+		subConfig := obj.(map[string]interface{})
+		subConfigs = append(subConfigs, PolicyBasedRouteSubConfig{
+			ID:   subConfig["id"].(string),
+			Type: subConfig["type"].(string),
+		})
+	}
+	return subConfigs
+}
+
+func expandPolicyBasedRouteForwardingActions(d *schema.ResourceData) []PolicyBasedRouteForwardingAction {
+	actions := []PolicyBasedRouteForwardingAction{}
+	for _, act := range d.Get("forwarding_actions").([]interface{}) {
+		action := act.(map[string]interface{})
+		matchACL := action["match_acl"].([]interface{})[0].(map[string]interface{})
+		actions = append(actions, PolicyBasedRouteForwardingAction{
+			Forwardingactiontype: "SET_EGRESS_INTF",
+			Matchcriteria: PolicyBasedRouteSubConfig{
+				ID:   matchACL["id"].(string),
+				Type: matchACL["type"].(string),
+			},
+			Egressinterfaces:   expandPolicyBasedRouteSubConfigs(action["egress_interfaces"].([]interface{})),
+			Interfaceordertype: strings.ToUpper(action["interface_ordering"].(string)),
+		})
+	}
+	return actions
+}
+
+func resourceFmcDevicePolicyBasedRoutesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	if err := c.requireFmcVersion(ctx, "fmc_device_policy_based_routes", policy_based_route_minimum_version); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create policy based route",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	res, err := c.CreateFmcPolicyBasedRoute(ctx, d.Get("device").(string), &PolicyBasedRoute{
+		Type:              policy_based_route_type,
+		Ingressinterfaces: expandPolicyBasedRouteSubConfigs(d.Get("ingress_interfaces").([]interface{})),
+		Forwardingactions: expandPolicyBasedRouteForwardingActions(d),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create policy based route",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcDevicePolicyBasedRoutesRead(ctx, d, m)
+}
+
+func resourceFmcDevicePolicyBasedRoutesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcPolicyBasedRoute(ctx, d.Get("device").(string), d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read policy based route",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ingressInterfaces := make([]interface{}, 0, len(item.Ingressinterfaces))
+	for _, intf := range item.Ingressinterfaces {
+		ingressInterfaces = append(ingressInterfaces, map[string]interface{}{
+			"id":   intf.ID,
+			"type": intf.Type,
+		})
+	}
+
+	forwardingActions := make([]interface{}, 0, len(item.Forwardingactions))
+	for _, action := range item.Forwardingactions {
+		egressInterfaces := make([]interface{}, 0, len(action.Egressinterfaces))
+		for _, intf := range action.Egressinterfaces {
+			egressInterfaces = append(egressInterfaces, map[string]interface{}{
+				"id":   intf.ID,
+				"type": intf.Type,
+			})
+		}
+		forwardingActions = append(forwardingActions, map[string]interface{}{
+			"match_acl": []interface{}{map[string]interface{}{
+				"id":   action.Matchcriteria.ID,
+				"type": action.Matchcriteria.Type,
+			}},
+			"egress_interfaces":  egressInterfaces,
+			"interface_ordering": action.Interfaceordertype,
+		})
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":               item.Name,
+		"type":               item.Type,
+		"ingress_interfaces": ingressInterfaces,
+		"forwarding_actions": forwardingActions,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read policy based route",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcDevicePolicyBasedRoutesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("ingress_interfaces", "forwarding_actions") {
+		_, err := c.UpdateFmcPolicyBasedRoute(ctx, d.Get("device").(string), d.Id(), &PolicyBasedRoute{
+			ID:                d.Id(),
+			Type:              policy_based_route_type,
+			Ingressinterfaces: expandPolicyBasedRouteSubConfigs(d.Get("ingress_interfaces").([]interface{})),
+			Forwardingactions: expandPolicyBasedRouteForwardingActions(d),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update policy based route",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcDevicePolicyBasedRoutesRead(ctx, d, m)
+}
+
+func resourceFmcDevicePolicyBasedRoutesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcPolicyBasedRoute(ctx, d.Get("device").(string), d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("policy based route", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete policy based route",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,124 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcDevicePolicyBasedRouteBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+	ingressInterface := "GigabitEthernet0/1"
+	egressInterface := "GigabitEthernet0/0"
+	accessList := "PBR-ACL"
+	ordering := "INTERFACE_PRIORITY"
+	orderingUpdated := "ORDER"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcDevicePolicyBasedRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcDevicePolicyBasedRouteConfigBasic(device, ingressInterface, egressInterface, accessList, ordering),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDevicePolicyBasedRouteExists("fmc_device_policy_based_routes.test", map[string]string{
+						"forwarding_actions.0.interface_ordering": ordering,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcDevicePolicyBasedRouteConfigBasic(device, ingressInterface, egressInterface, accessList, orderingUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDevicePolicyBasedRouteExists("fmc_device_policy_based_routes.test", map[string]string{
+						"forwarding_actions.0.interface_ordering": orderingUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcDevicePolicyBasedRouteDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_device_policy_based_routes" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcPolicyBasedRoute(ctx, rs.Primary.Attributes["device"], id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcDevicePolicyBasedRouteConfigBasic(device, ingressInterface, egressInterface, accessList, ordering string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "device" {
+        name = "%s"
+    }
+    data "fmc_device_physical_interfaces" "ingress" {
+        device = data.fmc_devices.device.id
+        name   = "%s"
+    }
+    data "fmc_device_physical_interfaces" "egress" {
+        device = data.fmc_devices.device.id
+        name   = "%s"
+    }
+    data "fmc_extended_access_lists" "acl" {
+        name = "%s"
+    }
+    resource "fmc_device_policy_based_routes" "test" {
+        device = data.fmc_devices.device.id
+        ingress_interfaces {
+            id   = data.fmc_device_physical_interfaces.ingress.id
+            type = data.fmc_device_physical_interfaces.ingress.type
+        }
+        forwarding_actions {
+            match_acl {
+                id   = data.fmc_extended_access_lists.acl.id
+                type = data.fmc_extended_access_lists.acl.type
+            }
+            egress_interfaces {
+                id   = data.fmc_device_physical_interfaces.egress.id
+                type = data.fmc_device_physical_interfaces.egress.type
+            }
+            interface_ordering = "%s"
+        }
+    }
+    `, device, ingressInterface, egressInterface, accessList, ordering)
+}
+
+func testAccCheckFmcDevicePolicyBasedRouteExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
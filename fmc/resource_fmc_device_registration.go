@@ -0,0 +1,246 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFmcDeviceRegistration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for idempotent registration of FTD Devices in FMC, meant for cloud auto-scale groups\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_device_registration\" \"ftd\" {\n" +
+			"    name = \"ftdv-asg-1\"\n" +
+			"    host_name = \"10.0.1.10\"\n" +
+			"    serial_number = \"9A1B2C3D4E5\"\n" +
+			"    reg_key = var.reg_key\n" +
+			"    nat_id = var.nat_id\n" +
+			"    license_caps = [\"BASE\", \"THREAT\"]\n" +
+			"    performance_tier = \"FTDv30\"\n" +
+			"    access_policy = fmc_access_policy.access_policy.id\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** If a device with the same name (or serial number, if given) is already registered, it is adopted instead of registered again. " +
+			"On destroy, the licenses of the device are released before the device is deregistered, unless `cleanup_licenses` is false.",
+		CreateContext: resourceFmcDeviceRegistrationCreate,
+		ReadContext:   resourceFmcDeviceRegistrationRead,
+		DeleteContext: resourceFmcDeviceRegistrationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of this resource, used to identify already registered devices",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The serial number of the FTD device, used to identify already registered devices before the name",
+			},
+			"host_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Hostname or IP address of the FTD device",
+			},
+			"reg_key": writeOnlySecret(&schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Registration key configured on the FTD device, only a hash of it is stored in the state",
+			}),
+			"nat_id": writeOnlySecret(&schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "NAT ID configured on the FTD device, required if the device is behind NAT, only a hash of it is stored in the state",
+			}),
+			"license_caps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `License capabilities for this resource, e.g. "BASE", "THREAT", "MALWARE", "URLFilter"`,
+			},
+			"performance_tier": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Performance tier for FTDv, e.g. "FTDv5", "FTDv10", "FTDv20", "FTDv30", "FTDv50", "FTDv100" or "Legacy"`,
+			},
+			"access_policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the access policy to assign during registration",
+			},
+			"cleanup_licenses": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Release the licenses of the device before deregistering it on destroy",
+			},
+			"already_registered": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the device was already registered and adopted by this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func resourceFmcDeviceRegistrationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	var existing *Device
+	if serialNumber, ok := d.GetOk("serial_number"); ok {
+		existing, _ = c.GetFmcDeviceBySerialNumber(ctx, serialNumber.(string))
+	}
+	if existing == nil {
+		existing, _ = c.GetFmcDeviceByName(ctx, name)
+	}
+	if existing != nil {
+		d.SetId(existing.ID)
+		if err := d.Set("already_registered", true); err != nil {
+			return returnWithDiag(diags, err)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "device already registered",
+			Detail:   fmt.Sprintf("device %s is already registered with ID %s, adopting it instead of registering it again", existing.Name, existing.ID),
+		})
+		return append(diags, resourceFmcDeviceRegistrationRead(ctx, d, m)...)
+	}
+
+	licenseCaps := []string{}
+	for _, licenseCap := range d.Get("license_caps").([]interface{}) {
+		licenseCaps = append(licenseCaps, licenseCap.(string))
+	}
+	id, err := registerFmcDevice(ctx, c, &DeviceRegistration{
+		Type:            device_type,
+		Name:            name,
+		Hostname:        d.Get("host_name").(string),
+		Regkey:          d.Get("reg_key").(string),
+		Natid:           d.Get("nat_id").(string),
+		Licensecaps:     licenseCaps,
+		Performancetier: d.Get("performance_tier").(string),
+		Accesspolicy: &DeviceSubConfig{
+			ID:   d.Get("access_policy").(string),
+			Type: access_policy_type,
+		},
+	}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		// Keep a device registered despite the error in the state, terraform marks it as tainted
+		d.SetId(id)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to register device",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(id)
+	if err := d.Set("already_registered", false); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	return resourceFmcDeviceRegistrationRead(ctx, d, m)
+}
+
+func resourceFmcDeviceRegistrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcDevice(ctx, d.Id())
+	if err != nil {
+		// Device was deregistered outside of terraform, e.g. by scale-in, so register it again
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("type", item.Type); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceFmcDeviceRegistrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	if d.Get("cleanup_licenses").(bool) {
+		_, err := c.UpdateFmcDeviceLicenses(ctx, id, &DeviceLicenseUpdate{
+			ID:          id,
+			Type:        device_type,
+			Name:        d.Get("name").(string),
+			Licensecaps: []string{},
+		})
+		if err != nil && !isNotFound(err) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to release device licenses",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	err := c.DeleteFmcDevice(ctx, id)
+	// Device is already deregistered, which is fine for scale-in
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to deregister device",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
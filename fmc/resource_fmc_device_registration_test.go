@@ -0,0 +1,84 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcDeviceRegistrationBasic(t *testing.T) {
+	name := "ftdv-asg-test"
+	hostName := "10.106.107.231"
+	regKey := "cisco123"
+	policy := "FTD"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcDeviceRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcDeviceRegistrationConfigBasic(name, hostName, regKey, policy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceRegistrationExists("fmc_device_registration.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcDeviceRegistrationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_device_registration" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcDevice(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcDeviceRegistrationConfigBasic(name, hostName, regKey, policy string) string {
+	return fmt.Sprintf(`
+    data "fmc_access_policies" "access_policy" {
+        name = "%s"
+    }
+    resource "fmc_device_registration" "test" {
+        name          = "%s"
+        host_name     = "%s"
+        reg_key       = "%s"
+        license_caps  = ["BASE"]
+        access_policy = data.fmc_access_policies.access_policy.id
+    }
+    `, policy, name, hostName, regKey)
+}
+
+func testAccCheckFmcDeviceRegistrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		return nil
+	}
+}
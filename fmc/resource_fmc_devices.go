@@ -0,0 +1,273 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var device_type string = "Device"
+
+func resourceFmcDevices() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for registering FTD Devices in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_devices\" \"ftd\" {\n" +
+			"    name = \"ftdv-cloud-1\"\n" +
+			"    host_name = \"10.0.1.10\"\n" +
+			"    reg_key = var.reg_key\n" +
+			"    nat_id = var.nat_id\n" +
+			"    license_caps = [\"BASE\", \"THREAT\"]\n" +
+			"    performance_tier = \"FTDv30\"\n" +
+			"    access_policy = fmc_access_policy.access_policy.id\n" +
+			"    timeouts {\n" +
+			"        create = \"45m\"\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Cloud FTDv instances can take many minutes before they accept registration. " +
+			"The registration is retried, and the registration task is polled until the device is ready, for up to the `create` timeout (30 minutes by default).",
+		CreateContext: resourceFmcDevicesCreate,
+		ReadContext:   resourceFmcDevicesRead,
+		UpdateContext: resourceFmcDevicesUpdate,
+		DeleteContext: resourceFmcDevicesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of this resource",
+			},
+			"host_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Hostname or IP address of the FTD device",
+			},
+			"reg_key": writeOnlySecret(&schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Registration key configured on the FTD device, only a hash of it is stored in the state",
+			}),
+			"nat_id": writeOnlySecret(&schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "NAT ID configured on the FTD device, required if the device is behind NAT, only a hash of it is stored in the state",
+			}),
+			"license_caps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `License capabilities for this resource, e.g. "BASE", "THREAT", "MALWARE", "URLFilter"`,
+			},
+			"performance_tier": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Performance tier for FTDv, e.g. "FTDv5", "FTDv10", "FTDv20", "FTDv30", "FTDv50", "FTDv100" or "Legacy"`,
+			},
+			"access_policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the access policy to assign during registration",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+			"model": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The model of the FTD device",
+			},
+			"sw_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The software version of the FTD device",
+			},
+		},
+	}
+}
+
+func resourceFmcDevicesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	licenseCaps := []string{}
+	for _, licenseCap := range d.Get("license_caps").([]interface{}) {
+		licenseCaps = append(licenseCaps, licenseCap.(string))
+	}
+	device := &DeviceRegistration{
+		Type:            device_type,
+		Name:            d.Get("name").(string),
+		Hostname:        d.Get("host_name").(string),
+		Regkey:          d.Get("reg_key").(string),
+		Natid:           d.Get("nat_id").(string),
+		Licensecaps:     licenseCaps,
+		Performancetier: d.Get("performance_tier").(string),
+		Accesspolicy: &DeviceSubConfig{
+			ID:   d.Get("access_policy").(string),
+			Type: access_policy_type,
+		},
+	}
+
+	id, err := registerFmcDevice(ctx, c, device, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		// Keep a device registered despite the error in the state, terraform marks it as tainted
+		d.SetId(id)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to register device",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(id)
+	return resourceFmcDevicesRead(ctx, d, m)
+}
+
+// registerFmcDevice registers the device and waits until it shows up in the device records.
+// Failed registration attempts are retried until the timeout, since cloud devices are not ready right away.
+func registerFmcDevice(ctx context.Context, c *Client, device *DeviceRegistration, timeout time.Duration) (string, error) {
+	var id, taskID string
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		// The device shows up in device records only once registration succeeded
+		if registered, err := c.GetFmcDeviceByName(ctx, device.Name); err == nil {
+			id = registered.ID
+			return nil
+		}
+		if taskID == "" {
+			res, err := c.CreateFmcDevice(ctx, device)
+			if err != nil {
+				// Device is most likely still provisioning and not reachable yet
+				log.Printf("device %s not ready for registration yet: %s", device.Name, err.Error())
+				return resource.RetryableError(err)
+			}
+			taskID = res.Metadata.Task.ID
+		}
+		if taskID != "" {
+			task, err := c.GetFmcTaskStatus(ctx, taskID)
+			if err != nil {
+				return resource.RetryableError(err)
+			}
+			if strings.EqualFold(task.Status, "FAILED") {
+				// Start over with a new registration request
+				taskID = ""
+				return resource.RetryableError(fmt.Errorf("registration of device %s failed: %s", device.Name, task.Message))
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("device %s is not registered yet", device.Name))
+	})
+	if err != nil && id == "" && taskID != "" {
+		// Registration was started before the wait was interrupted or timed out, so the device may have been
+		// registered anyway. Look it up with a fresh context to hand the ID back along with the error, so that
+		// terraform keeps the device in the state as tainted instead of losing track of it.
+		lookupCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if registered, lookupErr := c.GetFmcDeviceByName(lookupCtx, device.Name); lookupErr == nil {
+			id = registered.ID
+		}
+	}
+	return id, err
+}
+
+func resourceFmcDevicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcDevice(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read device",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":       item.Name,
+		"type":       item.Type,
+		"model":      item.Model,
+		"sw_version": item.Sw_version,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read device",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcDevicesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("name") {
+		_, err := c.UpdateFmcDevice(ctx, d.Id(), &DeviceRegistration{
+			ID:   d.Id(),
+			Type: device_type,
+			Name: d.Get("name").(string),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update device",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcDevicesRead(ctx, d, m)
+}
+
+func resourceFmcDevicesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcDevice(ctx, d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("device", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete device",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,101 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcDeviceBasic(t *testing.T) {
+	name := "ftdv-test"
+	nameUpdated := "ftdv-test-renamed"
+	hostName := "10.106.107.230"
+	regKey := "cisco123"
+	policy := "FTD"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcDeviceConfigBasic(name, hostName, regKey, policy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceExists("fmc_devices.test", map[string]string{
+						"name": name,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcDeviceConfigBasic(nameUpdated, hostName, regKey, policy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDeviceExists("fmc_devices.test", map[string]string{
+						"name": nameUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcDeviceDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_devices" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcDevice(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcDeviceConfigBasic(name, hostName, regKey, policy string) string {
+	return fmt.Sprintf(`
+    data "fmc_access_policies" "access_policy" {
+        name = "%s"
+    }
+    resource "fmc_devices" "test" {
+        name          = "%s"
+        host_name     = "%s"
+        reg_key       = "%s"
+        license_caps  = ["BASE"]
+        access_policy = data.fmc_access_policies.access_policy.id
+    }
+    `, policy, name, hostName, regKey)
+}
+
+func testAccCheckFmcDeviceExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,217 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dns_server_group_type string = "DNSServerGroupObject"
+
+func resourceFmcDNSServerGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for DNS Server Group Objects in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_dns_server_groups\" \"corporate\" {\n" +
+			"    name = \"Corporate DNS\"\n" +
+			"    servers = [\"10.10.10.53\", \"10.10.20.53\"]\n" +
+			"    default_domain = \"example.com\"\n" +
+			"    retries = 3\n" +
+			"    timeout = 5\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The servers are queried in the order given. FQDN objects are only resolved on the devices " +
+			"once the group is assigned to their platform settings with `fmc_ftd_dns`.",
+		CreateContext: resourceFmcDNSServerGroupsCreate,
+		ReadContext:   resourceFmcDNSServerGroupsRead,
+		UpdateContext: resourceFmcDNSServerGroupsUpdate,
+		DeleteContext: resourceFmcDNSServerGroupsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 6,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						v := val.(string)
+						if net.ParseIP(v) == nil {
+							errs = append(errs, fmt.Errorf("%q must be an IPv4 or IPv6 address, got: %q", key, v))
+						}
+						return
+					},
+				},
+				Description: "The addresses of the DNS servers of this resource, at most 6",
+			},
+			"default_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The domain appended to hostnames which are not fully qualified",
+			},
+			"retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v < 0 || v > 10 {
+						errs = append(errs, fmt.Errorf("%q must be between 0 and 10 inclusive, got: %d", key, v))
+					}
+					return
+				},
+				Description: "The number of retries of a query, defaults to 2",
+			},
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v < 1 || v > 30 {
+						errs = append(errs, fmt.Errorf("%q must be between 1 and 30 inclusive, got: %d", key, v))
+					}
+					return
+				},
+				Description: "The seconds to wait for an answer before the next server is queried, defaults to 2",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandDNSServerGroupObject(d *schema.ResourceData) *DNSServerGroupObject {
+	object := &DNSServerGroupObject{
+		Name:          d.Get("name").(string),
+		Type:          dns_server_group_type,
+		Defaultdomain: d.Get("default_domain").(string),
+		Retries:       d.Get("retries").(int),
+		Timeout:       d.Get("timeout").(int),
+		Dnsservers:    []DNSServerGroupServer{},
+	}
+	for _, server := range d.Get("servers").([]interface{}) {
+		object.Dnsservers = append(object.Dnsservers, DNSServerGroupServer{
+			Nameserver: server.(string),
+		})
+	}
+	return object
+}
+
+func resourceFmcDNSServerGroupsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	res, err := c.CreateFmcDNSServerGroupObject(ctx, expandDNSServerGroupObject(d))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create dns server group object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcDNSServerGroupsRead(ctx, d, m)
+}
+
+func resourceFmcDNSServerGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcDNSServerGroupObject(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read dns server group object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":           item.Name,
+		"type":           item.Type,
+		"servers":        flattenDNSServerGroupServers(item.Dnsservers),
+		"default_domain": item.Defaultdomain,
+		"retries":        item.Retries,
+		"timeout":        item.Timeout,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read dns server group object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcDNSServerGroupsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("name", "servers", "default_domain", "retries", "timeout") {
+		object := expandDNSServerGroupObject(d)
+		object.ID = d.Id()
+		_, err := c.UpdateFmcDNSServerGroupObject(ctx, d.Id(), object)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update dns server group object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcDNSServerGroupsRead(ctx, d, m)
+}
+
+func resourceFmcDNSServerGroupsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcDNSServerGroupObject(ctx, d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("dns server group object", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete dns server group object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,96 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcDNSServerGroupObjectBasic(t *testing.T) {
+	name := "test_dns_server_group"
+	server := "10.10.10.53"
+	serverUpdated := "10.10.20.53"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcDNSServerGroupObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcDNSServerGroupObjectConfigBasic(name, server),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDNSServerGroupObjectExists("fmc_dns_server_groups.test", map[string]string{
+						"name":      name,
+						"servers.0": server,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcDNSServerGroupObjectConfigBasic(name, serverUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcDNSServerGroupObjectExists("fmc_dns_server_groups.test", map[string]string{
+						"name":      name,
+						"servers.0": serverUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcDNSServerGroupObjectDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_dns_server_groups" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcDNSServerGroupObject(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcDNSServerGroupObjectConfigBasic(name, server string) string {
+	return fmt.Sprintf(`
+    resource "fmc_dns_server_groups" "test" {
+        name           = "%s"
+        servers        = ["%s"]
+        default_domain = "example.com"
+    }
+    `, name, server)
+}
+
+func testAccCheckFmcDNSServerGroupObjectExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
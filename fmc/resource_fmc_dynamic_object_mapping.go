@@ -145,7 +145,9 @@ func resourceFmcDynamicObjectMappingDelete(ctx context.Context, d *schema.Resour
 	}
 
 	err = c.DeleteFmcDynamicObjectMapping(ctx, dynamicObjectMapping)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("dynamic object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete dynamic object",
@@ -30,10 +30,11 @@ func resourceFmcDynamicObjects() *schema.Resource {
 		DeleteContext: resourceFmcDynamicObjectsDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"object_type": {
 				Type:        schema.TypeString,
@@ -63,13 +64,7 @@ func resourceFmcDynamicObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 		},
 	}
@@ -83,7 +78,7 @@ func resourceFmcDynamicObjectsCreate(ctx context.Context, d *schema.ResourceData
 
 	res, err := c.CreateFmcDynamicObject(ctx, &DynamicObject{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Type:        dynamicObjectType,
 		ObjectType:  d.Get("object_type").(string),
 	})
@@ -152,7 +147,7 @@ func resourceFmcDynamicObjectsUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChanges("name", "description", "object_type") {
 		_, err := c.UpdateFmcDynamicObject(ctx, id, &DynamicObjectUpdated{
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			ObjectType:  d.Get("object_type").(string),
 			Type:        network_type,
 			ID:          id,
@@ -178,7 +173,9 @@ func resourceFmcDynamicObjectsDelete(ctx context.Context, d *schema.ResourceData
 	id := d.Id()
 
 	err := c.DeleteFmcDynamicObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("dynamic object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete dynamic object",
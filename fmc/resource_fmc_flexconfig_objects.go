@@ -0,0 +1,226 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var flexconfig_object_type string = "FlexConfigObject"
+
+func resourceFmcFlexConfigObjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for FlexConfig Objects in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_flexconfig_objects\" \"ecmp\" {\n" +
+			"    name = \"ECMP_Zone\"\n" +
+			"    description = \"ECMP zone for the outside interfaces\"\n" +
+			"    deploy = \"EVERYTIME\"\n" +
+			"    object_type = \"APPEND\"\n" +
+			"    content = \"zone $ecmp_zone_name ecmp\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Text objects created with `fmc_text_objects` can be referenced as variables in the content with `$name`.",
+		CreateContext: resourceFmcFlexConfigObjectsCreate,
+		ReadContext:   resourceFmcFlexConfigObjectsRead,
+		UpdateContext: resourceFmcFlexConfigObjectsUpdate,
+		DeleteContext: resourceFmcFlexConfigObjectsDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of this resource",
+				Default:     " ",
+				StateFunc: func(val interface{}) string {
+					state := val.(string)
+					if val == nil || state == "" || state == " " {
+						return " "
+					}
+					return state
+				},
+				DiffSuppressFunc: suppressDescriptionMarker,
+			},
+			"deploy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "EVERYTIME",
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"ONCE", "EVERYTIME"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `When to deploy this resource, "ONCE" or "EVERYTIME"`,
+			},
+			"object_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "APPEND",
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"APPEND", "PREPEND"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `Whether this resource is appended or prepended to the generated configuration, "APPEND" or "PREPEND"`,
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CLI content of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func resourceFmcFlexConfigObjectsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	res, err := c.CreateFmcFlexConfigObject(ctx, &FlexConfigObject{
+		Name:        d.Get("name").(string),
+		Type:        flexconfig_object_type,
+		Description: c.withDescriptionMarker(d),
+		Deploy:      strings.ToUpper(d.Get("deploy").(string)),
+		Objecttype:  strings.ToUpper(d.Get("object_type").(string)),
+		Content:     d.Get("content").(string),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create flexconfig object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcFlexConfigObjectsRead(ctx, d, m)
+}
+
+func resourceFmcFlexConfigObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcFlexConfigObject(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read flexconfig object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":        item.Name,
+		"type":        item.Type,
+		"description": item.Description,
+		"deploy":      item.Deploy,
+		"object_type": item.Objecttype,
+		"content":     item.Content,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read flexconfig object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcFlexConfigObjectsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("name", "description", "deploy", "object_type", "content") {
+		_, err := c.UpdateFmcFlexConfigObject(ctx, d.Id(), &FlexConfigObject{
+			ID:          d.Id(),
+			Name:        d.Get("name").(string),
+			Type:        flexconfig_object_type,
+			Description: c.withDescriptionMarker(d),
+			Deploy:      strings.ToUpper(d.Get("deploy").(string)),
+			Objecttype:  strings.ToUpper(d.Get("object_type").(string)),
+			Content:     d.Get("content").(string),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update flexconfig object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcFlexConfigObjectsRead(ctx, d, m)
+}
+
+func resourceFmcFlexConfigObjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcFlexConfigObject(ctx, d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("flexconfig object", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete flexconfig object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
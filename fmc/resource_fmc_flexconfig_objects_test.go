@@ -0,0 +1,97 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcFlexConfigObjectBasic(t *testing.T) {
+	name := "test_flexconfig_object"
+	content := "zone outside-ecmp ecmp"
+	contentUpdated := "no zone outside-ecmp ecmp"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcFlexConfigObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcFlexConfigObjectConfigBasic(name, content),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFlexConfigObjectExists("fmc_flexconfig_objects.test", map[string]string{
+						"name":    name,
+						"content": content,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcFlexConfigObjectConfigBasic(name, contentUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFlexConfigObjectExists("fmc_flexconfig_objects.test", map[string]string{
+						"name":    name,
+						"content": contentUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcFlexConfigObjectDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_flexconfig_objects" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcFlexConfigObject(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcFlexConfigObjectConfigBasic(name, content string) string {
+	return fmt.Sprintf(`
+    resource "fmc_flexconfig_objects" "test" {
+        name        = "%s"
+        deploy      = "EVERYTIME"
+        object_type = "APPEND"
+        content     = "%s"
+    }
+    `, name, content)
+}
+
+func testAccCheckFmcFlexConfigObjectExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,228 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var flexconfig_policy_type string = "FlexConfigPolicy"
+
+func resourceFmcFlexConfigPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for FlexConfig Policies in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_flexconfig_policies\" \"flexconfig\" {\n" +
+			"    name = \"FTD FlexConfig\"\n" +
+			"    description = \"FlexConfig policy for the FTD devices\"\n" +
+			"    append_flexconfigs {\n" +
+			"        id = fmc_flexconfig_objects.ecmp.id\n" +
+			"        type = fmc_flexconfig_objects.ecmp.type\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Assign the policy to devices with `fmc_policy_devices_assignments`.",
+		CreateContext: resourceFmcFlexConfigPoliciesCreate,
+		ReadContext:   resourceFmcFlexConfigPoliciesRead,
+		UpdateContext: resourceFmcFlexConfigPoliciesUpdate,
+		DeleteContext: resourceFmcFlexConfigPoliciesDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_policy_name_max_length),
+				Description:  "The name of this resource",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDescriptionMarker,
+				Description:      "The description of this resource",
+			},
+			"prepend_flexconfigs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of this resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of this resource",
+						},
+					},
+				},
+				Description: "FlexConfig objects deployed before the generated configuration, in order",
+			},
+			"append_flexconfigs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of this resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of this resource",
+						},
+					},
+				},
+				Description: "FlexConfig objects deployed after the generated configuration, in order",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandFlexConfigPolicyObjects(d *schema.ResourceData, key string) []FlexConfigPolicySubConfig {
+	objects := []FlexConfigPolicySubConfig{}
+	for _, obj := range d.Get(key).([]interface{}) {
+		object := obj.(map[string]interface{})
+		objects = append(objects, FlexConfigPolicySubConfig{
+			ID:   object["id"].(string),
+			Type: object["type"].(string),
+		})
+	}
+	return objects
+}
+
+func resourceFmcFlexConfigPoliciesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	res, err := c.CreateFmcFlexConfigPolicy(ctx, &FlexConfigPolicy{
+		Name:               d.Get("name").(string),
+		Type:               flexconfig_policy_type,
+		Description:        c.withDescriptionMarker(d),
+		Prependflexconfigs: expandFlexConfigPolicyObjects(d, "prepend_flexconfigs"),
+		Appendflexconfigs:  expandFlexConfigPolicyObjects(d, "append_flexconfigs"),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create flexconfig policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcFlexConfigPoliciesRead(ctx, d, m)
+}
+
+func resourceFmcFlexConfigPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcFlexConfigPolicy(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read flexconfig policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	prependFlexConfigs := make([]interface{}, 0, len(item.Prependflexconfigs))
+	for _, obj := range item.Prependflexconfigs {
+		prependFlexConfigs = append(prependFlexConfigs, map[string]interface{}{
+			"id":   obj.ID,
+			"type": obj.Type,
+		})
+	}
+	appendFlexConfigs := make([]interface{}, 0, len(item.Appendflexconfigs))
+	for _, obj := range item.Appendflexconfigs {
+		appendFlexConfigs = append(appendFlexConfigs, map[string]interface{}{
+			"id":   obj.ID,
+			"type": obj.Type,
+		})
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":                item.Name,
+		"type":                item.Type,
+		"description":         item.Description,
+		"prepend_flexconfigs": prependFlexConfigs,
+		"append_flexconfigs":  appendFlexConfigs,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read flexconfig policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcFlexConfigPoliciesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("name", "description", "prepend_flexconfigs", "append_flexconfigs") {
+		_, err := c.UpdateFmcFlexConfigPolicy(ctx, d.Id(), &FlexConfigPolicy{
+			ID:                 d.Id(),
+			Name:               d.Get("name").(string),
+			Type:               flexconfig_policy_type,
+			Description:        c.withDescriptionMarker(d),
+			Prependflexconfigs: expandFlexConfigPolicyObjects(d, "prepend_flexconfigs"),
+			Appendflexconfigs:  expandFlexConfigPolicyObjects(d, "append_flexconfigs"),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update flexconfig policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcFlexConfigPoliciesRead(ctx, d, m)
+}
+
+func resourceFmcFlexConfigPoliciesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcFlexConfigPolicy(ctx, d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("flexconfig policy", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete flexconfig policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
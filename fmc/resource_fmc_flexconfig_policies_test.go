@@ -0,0 +1,119 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcFlexConfigPolicyBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+	name := "test_flexconfig_policy"
+	description := "test flexconfig policy"
+	descriptionUpdated := "test flexconfig policy updated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcFlexConfigPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcFlexConfigPolicyConfigBasic(device, name, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFlexConfigPolicyExists("fmc_flexconfig_policies.test", map[string]string{
+						"name":                 name,
+						"description":          description,
+						"append_flexconfigs.#": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcFlexConfigPolicyConfigBasic(device, name, descriptionUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFlexConfigPolicyExists("fmc_flexconfig_policies.test", map[string]string{
+						"name":                 name,
+						"description":          descriptionUpdated,
+						"append_flexconfigs.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcFlexConfigPolicyDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_flexconfig_policies" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcFlexConfigPolicy(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcFlexConfigPolicyConfigBasic(device, name, description string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "device" {
+        name = "%s"
+    }
+    resource "fmc_flexconfig_objects" "ecmp" {
+        name    = "test_flexconfig_policy_object"
+        content = "zone outside-ecmp ecmp"
+    }
+    resource "fmc_flexconfig_policies" "test" {
+        name        = "%s"
+        description = "%s"
+        append_flexconfigs {
+            id   = fmc_flexconfig_objects.ecmp.id
+            type = fmc_flexconfig_objects.ecmp.type
+        }
+    }
+    resource "fmc_policy_devices_assignments" "test" {
+        policy {
+            id   = fmc_flexconfig_policies.test.id
+            type = fmc_flexconfig_policies.test.type
+        }
+        target_devices {
+            id   = data.fmc_devices.device.id
+            type = data.fmc_devices.device.type
+        }
+    }
+    `, device, name, description)
+}
+
+func testAccCheckFmcFlexConfigPolicyExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
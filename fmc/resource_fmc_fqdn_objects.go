@@ -2,6 +2,9 @@ package fmc
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,6 +12,9 @@ import (
 
 var fqdn_type string = "FQDN"
 
+// A hostname of labels of letters, digits, hyphens and underscores, e.g. "login.microsoftonline.com"
+var fqdn_value_regexp = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.)*[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.?$`)
+
 func resourceFmcFQDNObjects() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource for FQDN Objects in FMC\n" +
@@ -22,21 +28,35 @@ func resourceFmcFQDNObjects() *schema.Resource {
 			"  description = \"Cisco domain\"\n" +
 			"  dns_resolution = \"IPV4_ONLY\"\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** FQDN objects are only resolved on the devices whose platform settings have DNS resolution enabled, see `fmc_ftd_dns`.\n" +
+			"\n" +
+			"**Note** Existing objects can be imported either by ID or by name, e.g. `terraform import fmc_fqdn_objects.new name=Cisco`.",
 		CreateContext: resourceFmcFQDNObjectsCreate,
 		ReadContext:   resourceFmcFQDNObjectsRead,
 		UpdateContext: resourceFmcFQDNObjectsUpdate,
 		DeleteContext: resourceFmcFQDNObjectsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFmcFQDNObjectsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The value of this resource",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if len(v) > 253 || !fqdn_value_regexp.MatchString(v) {
+						errs = append(errs, fmt.Errorf("%q must be a hostname, e.g. \"cisco.com\", got: %q", key, v))
+					}
+					return
+				},
+				Description: "The hostname of this resource, e.g. \"cisco.com\"",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -50,17 +70,22 @@ func resourceFmcFQDNObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"dns_resolution": {
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					allowedValues := []string{"IPV4_ONLY", "IPV6_ONLY", "IPV4_AND_IPV6"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
 				Description: `DNS resolution, "IPV4_ONLY", "IPV6_ONLY" or "IPV4_AND_IPV6"`,
 			},
 		},
@@ -75,7 +100,7 @@ func resourceFmcFQDNObjectsCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := c.CreateFmcFQDNObject(ctx, &FQDNObject{
 		Name:          d.Get("name").(string),
-		Description:   d.Get("description").(string),
+		Description:   c.withDescriptionMarker(d),
 		Value:         d.Get("value").(string),
 		DNSResolution: d.Get("dns_resolution").(string),
 		Type:          fqdn_type,
@@ -152,7 +177,7 @@ func resourceFmcFQDNObjectsUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChanges("name", "description", "value", "dns_resolution") {
 		_, err := c.UpdateFmcFQDNObject(ctx, id, &FQDNObjectUpdateInput{
 			Name:          d.Get("name").(string),
-			Description:   d.Get("description").(string),
+			Description:   c.withDescriptionMarker(d),
 			Value:         d.Get("value").(string),
 			DNSResolution: d.Get("dns_resolution").(string),
 			Type:          fqdn_type,
@@ -179,7 +204,9 @@ func resourceFmcFQDNObjectsDelete(ctx context.Context, d *schema.ResourceData, m
 	id := d.Id()
 
 	err := c.DeleteFmcFQDNObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("fqdn object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete fqdn object",
@@ -194,3 +221,24 @@ func resourceFmcFQDNObjectsDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// resourceFmcFQDNObjectsImport accepts either the ID of the object or "name=<object name>", like the import of
+// the network objects
+func resourceFmcFQDNObjectsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*Client)
+
+	if !strings.HasPrefix(d.Id(), "name=") {
+		return []*schema.ResourceData{d}, nil
+	}
+	name := strings.TrimPrefix(d.Id(), "name=")
+	item, err := c.GetFmcFQDNObjectByNameOrValue(ctx, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import fqdn object %s: %s", name, err.Error())
+	}
+	// The lookup also matches on the value, only accept an exact name match
+	if item.Name != name {
+		return nil, fmt.Errorf("unable to import fqdn object %s: no fqdn object found with this name", name)
+	}
+	d.SetId(item.ID)
+	return []*schema.ResourceData{d}, nil
+}
@@ -26,6 +26,17 @@ func TestAccFmcFQDNObjectBasic(t *testing.T) {
 					testAccCheckFmcFQDNObjectExists("fmc_fqdn_objects.test"),
 				),
 			},
+			{
+				ResourceName:      "fmc_fqdn_objects.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "fmc_fqdn_objects.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("name=%s", name),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -77,3 +88,30 @@ func testAccCheckFmcFQDNObjectExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestFQDNObjectValidation(t *testing.T) {
+	schema := resourceFmcFQDNObjects().Schema
+	for value, valid := range map[string]bool{
+		"cisco.com":                 true,
+		"login.microsoftonline.com": true,
+		"_sip._tcp.example.com":     true,
+		"localhost":                 true,
+		"https://cisco.com":         false,
+		"cisco com":                 false,
+		"-cisco.com":                false,
+	} {
+		if _, errs := schema["value"].ValidateFunc(value, "value"); (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got %v", value, valid, errs)
+		}
+	}
+	for resolution, valid := range map[string]bool{
+		"IPV4_ONLY":     true,
+		"IPV4_AND_IPV6": true,
+		"ipv6_only":     false,
+		"IPV6":          false,
+	} {
+		if _, errs := schema["dns_resolution"].ValidateFunc(resolution, "dns_resolution"); (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got %v", resolution, valid, errs)
+		}
+	}
+}
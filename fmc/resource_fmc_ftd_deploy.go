@@ -3,6 +3,7 @@ package fmc
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,8 +20,11 @@ func resourceFmcFtdDeploy() *schema.Resource {
 			"    device = data.fmc_devices.ftd.id\n" +
 			"    ignore_warning = false\n" +
 			"    force_deploy = false\n" +
+			"    allow_traffic_interruption = false\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** Deployments which restart snort interrupt the traffic through the device, a warning is shown for them. " +
+			"Set `allow_traffic_interruption = false` to fail instead of deploying them outside of change windows.",
 		CreateContext: resourceFmcFtdDeployCreate,
 		ReadContext:   resourceFmcFtdDeployRead,
 		UpdateContext: resourceFmcFtdDeployCreate,
@@ -38,6 +42,12 @@ func resourceFmcFtdDeploy() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"allow_traffic_interruption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Deploy even if the deployment interrupts the traffic, e.g. by restarting snort",
+			},
 		},
 	}
 }
@@ -59,6 +69,21 @@ func resourceFmcFtdDeployCreate(ctx context.Context, d *schema.ResourceData, m i
 		})
 		return diags
 	}
+	if strings.EqualFold(device.Trafficinterruption, "yes") {
+		if !d.Get("allow_traffic_interruption").(bool) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Deployment interrupts the traffic!",
+				Detail:   fmt.Sprintf("Deploying to device Name: %s ID: %s restarts snort, set allow_traffic_interruption to deploy anyway", device.Name, device_id),
+			})
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Deployment interrupts the traffic!",
+			Detail:   fmt.Sprintf("Deploying to device Name: %s ID: %s restarts snort", device.Name, device_id),
+		})
+	}
 	object := FtdDeploy{
 		Type:          deployment_type,
 		Version:       device.Version,
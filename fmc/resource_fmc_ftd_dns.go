@@ -0,0 +1,244 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ftd_dns_settings_type string = "DNSSetting"
+
+func resourceFmcFTDDNS() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for the DNS settings of FTD Platform Settings Policies in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_ftd_dns\" \"dns\" {\n" +
+			"    platform_settings = data.fmc_ftd_platform_settings_policies.platform_settings.id\n" +
+			"    dns_server_group = data.fmc_dns_server_groups.default.id\n" +
+			"    interfaces {\n" +
+			"        id = data.fmc_security_zones.inside.id\n" +
+			"        type = data.fmc_security_zones.inside.type\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The DNS servers are used by the devices the platform settings policy is assigned to, " +
+			"FQDN objects in access rules are only resolved with DNS resolution enabled. Without `interfaces` the DNS servers are reached through any interface. " +
+			"On destroy, DNS resolution is disabled in the platform settings policy.",
+		CreateContext: resourceFmcFTDDNSCreate,
+		ReadContext:   resourceFmcFTDDNSRead,
+		UpdateContext: resourceFmcFTDDNSUpdate,
+		DeleteContext: resourceFmcFTDDNSDelete,
+		Schema: map[string]*schema.Schema{
+			"platform_settings": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD platform settings policy",
+			},
+			"dns_server_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the DNS server group object",
+			},
+			"interfaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the security zone or interface group",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The type of the security zone or interface group, "SecurityZone" or "InterfaceGroup"`,
+						},
+					},
+				},
+				Description: "The interfaces the DNS servers are reached through",
+			},
+			"expiry_entry_timer": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v < 1 || v > 65535 {
+						errs = append(errs, fmt.Errorf("%q must be between 1 and 65535 inclusive, got: %d", key, v))
+					}
+					return
+				},
+				Description: "The minutes after the TTL of a resolved FQDN expires before it is removed, defaults to 1",
+			},
+			"poll_timer": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  240,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v < 1 || v > 65535 {
+						errs = append(errs, fmt.Errorf("%q must be between 1 and 65535 inclusive, got: %d", key, v))
+					}
+					return
+				},
+				Description: "The minutes between the resolutions of the FQDN objects, defaults to 240",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandFTDDNSSettings(d *schema.ResourceData, id string) *FTDDNSSettings {
+	settings := &FTDDNSSettings{
+		ID:                  id,
+		Type:                ftd_dns_settings_type,
+		Enablednsresolution: true,
+		Dnsservergroup: &FTDPlatformSettingsSubConfig{
+			ID:   d.Get("dns_server_group").(string),
+			Type: dns_server_group_type,
+		},
+		Expiryentrytimer: d.Get("expiry_entry_timer").(int),
+		Polltimer:        d.Get("poll_timer").(int),
+	}
+	for _, obj := range d.Get("interfaces").(*schema.Set).List() {
+		obji := obj.(map[string]interface{})
+		settings.Interfaceobjects = append(settings.Interfaceobjects, FTDPlatformSettingsSubConfig{
+			ID:   obji["id"].(string),
+			Type: obji["type"].(string),
+		})
+	}
+	return settings
+}
+
+func resourceFmcFTDDNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	policyID := d.Get("platform_settings").(string)
+	settings, err := c.GetFmcFTDDNSSettings(ctx, policyID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ftd dns settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_, err = c.UpdateFmcFTDDNSSettings(ctx, policyID, settings.ID, expandFTDDNSSettings(d, settings.ID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ftd dns settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(settings.ID)
+	return resourceFmcFTDDNSRead(ctx, d, m)
+}
+
+func resourceFmcFTDDNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcFTDDNSSettings(ctx, d.Get("platform_settings").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read ftd dns settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// With DNS resolution disabled, e.g. in the FMC UI, the server group is not used
+	dnsServerGroup := ""
+	if item.Enablednsresolution && item.Dnsservergroup != nil {
+		dnsServerGroup = item.Dnsservergroup.ID
+	}
+	interfaces := make([]interface{}, 0, len(item.Interfaceobjects))
+	for _, obj := range item.Interfaceobjects {
+		interfaces = append(interfaces, map[string]interface{}{
+			"id":   obj.ID,
+			"type": obj.Type,
+		})
+	}
+
+	for key, value := range map[string]interface{}{
+		"type":               item.Type,
+		"dns_server_group":   dnsServerGroup,
+		"interfaces":         interfaces,
+		"expiry_entry_timer": item.Expiryentrytimer,
+		"poll_timer":         item.Polltimer,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read ftd dns settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcFTDDNSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("dns_server_group", "interfaces", "expiry_entry_timer", "poll_timer") {
+		_, err := c.UpdateFmcFTDDNSSettings(ctx, d.Get("platform_settings").(string), d.Id(), expandFTDDNSSettings(d, d.Id()))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update ftd dns settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcFTDDNSRead(ctx, d, m)
+}
+
+func resourceFmcFTDDNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The DNS settings cannot be deleted, so disable DNS resolution instead
+	_, err := c.UpdateFmcFTDDNSSettings(ctx, d.Get("platform_settings").(string), d.Id(), &FTDDNSSettings{
+		ID:   d.Id(),
+		Type: ftd_dns_settings_type,
+	})
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete ftd dns settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,80 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcFTDDNSBasic(t *testing.T) {
+	policy := "FTD Platform Settings"
+	group := "CiscoUmbrellaDNSServerGroup"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcFTDDNSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcFTDDNSConfigBasic(policy, group),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFTDDNSExists("fmc_ftd_dns.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcFTDDNSDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_ftd_dns" {
+			continue
+		}
+
+		settings, err := c.GetFmcFTDDNSSettings(context.Background(), rs.Primary.Attributes["platform_settings"])
+		if err != nil {
+			return err
+		}
+		if settings.Enablednsresolution {
+			return fmt.Errorf("dns settings were not reset: %+v", settings)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcFTDDNSConfigBasic(policy, group string) string {
+	return fmt.Sprintf(`
+    data "fmc_ftd_platform_settings_policies" "test" {
+        name = "%s"
+    }
+    data "fmc_dns_server_groups" "test" {
+        name = "%s"
+    }
+    resource "fmc_ftd_dns" "test" {
+        platform_settings = data.fmc_ftd_platform_settings_policies.test.id
+        dns_server_group  = data.fmc_dns_server_groups.test.id
+    }
+    `, policy, group)
+}
+
+func testAccCheckFmcFTDDNSExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,250 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ftd_s2s_vpn_ike_settings_type string = "IkeSetting"
+
+// Authentication types of the IKE settings
+var (
+	s2s_vpn_automatic_psk string = "AUTOMATIC_PRE_SHARED_KEY"
+	s2s_vpn_manual_psk    string = "MANUAL_PRE_SHARED_KEY"
+)
+
+func resourceFmcFTDS2SVPNPSK() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for the pre-shared keys of FTD Site to Site VPNs in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_ftd_s2s_vpn_psk\" \"branches\" {\n" +
+			"    vpn = data.fmc_ftd_s2s_vpns.branches.id\n" +
+			"    ike_version = \"IKEV2\"\n" +
+			"    pre_shared_key = var.branches_psk\n" +
+			"    psk_rotation_trigger = \"2024-Q1\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Without `pre_shared_key`, FMC generates the key of every endpoint automatically. " +
+			"FMC never returns pre-shared keys, so changes to them outside of terraform are not detected. " +
+			"Changing `psk_rotation_trigger` sends the pre-shared key settings to FMC again, use it to rotate the keys on a schedule " +
+			"or after they were changed outside of terraform. " +
+			"On destroy, the VPN is reset to automatically generated keys.",
+		CreateContext: resourceFmcFTDS2SVPNPSKCreate,
+		ReadContext:   resourceFmcFTDS2SVPNPSKRead,
+		UpdateContext: resourceFmcFTDS2SVPNPSKUpdate,
+		DeleteContext: resourceFmcFTDS2SVPNPSKDelete,
+		Schema: map[string]*schema.Schema{
+			"vpn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD site to site VPN topology this resource belongs to",
+			},
+			"ike_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "IKEV2",
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"IKEV1", "IKEV2"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `The IKE version whose pre-shared key is managed, "IKEV1" or "IKEV2"`,
+			},
+			"pre_shared_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Pre-shared key used by all endpoints of the VPN, the key is generated automatically if not set",
+			},
+			"automatic_key_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  24,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v >= 1 && v <= 127 {
+						return
+					}
+					errs = append(errs, fmt.Errorf("%q must be between 1 and 127, got: %d", key, v))
+					return
+				},
+				Description: "Length of the automatically generated pre-shared keys, between 1 and 127",
+			},
+			"psk_rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, changing it sends the pre-shared key settings to FMC again to rotate the keys",
+			},
+			"authentication_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The authentication type configured in FMC, "AUTOMATIC_PRE_SHARED_KEY" or "MANUAL_PRE_SHARED_KEY"`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+// ikeVersionSettings returns the settings of the IKE version, creating them if missing
+func ikeVersionSettings(settings *FTDS2SVPNIKESettings, ikeVersion string) *FTDS2SVPNIKEVersionSettings {
+	if strings.ToUpper(ikeVersion) == "IKEV1" {
+		if settings.Ikev1settings == nil {
+			settings.Ikev1settings = &FTDS2SVPNIKEVersionSettings{}
+		}
+		return settings.Ikev1settings
+	}
+	if settings.Ikev2settings == nil {
+		settings.Ikev2settings = &FTDS2SVPNIKEVersionSettings{}
+	}
+	return settings.Ikev2settings
+}
+
+// updateFmcFTDS2SVPNPSK sets the pre-shared key of the VPN, or automatically generated keys if the key is empty
+func updateFmcFTDS2SVPNPSK(ctx context.Context, c *Client, vpnID, ikeVersion, key string, keyLength int) (*FTDS2SVPNIKESettings, error) {
+	settings, err := c.GetFmcFTDS2SVPNIKESettings(ctx, vpnID)
+	if err != nil {
+		return nil, err
+	}
+	versionSettings := ikeVersionSettings(settings, ikeVersion)
+	versionSettings.Automaticpresharedkeylength = keyLength
+	if key == "" {
+		versionSettings.Authenticationtype = s2s_vpn_automatic_psk
+		versionSettings.Manualpresharedkey = ""
+	} else {
+		versionSettings.Authenticationtype = s2s_vpn_manual_psk
+		versionSettings.Manualpresharedkey = key
+	}
+	settings.Type = ftd_s2s_vpn_ike_settings_type
+	return c.UpdateFmcFTDS2SVPNIKESettings(ctx, vpnID, settings.ID, settings)
+}
+
+func resourceFmcFTDS2SVPNPSKCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	res, err := updateFmcFTDS2SVPNPSK(ctx, c, d.Get("vpn").(string), d.Get("ike_version").(string), d.Get("pre_shared_key").(string), d.Get("automatic_key_length").(int))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ftd s2s vpn pre-shared key",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcFTDS2SVPNPSKRead(ctx, d, m)
+}
+
+func resourceFmcFTDS2SVPNPSKRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcFTDS2SVPNIKESettings(ctx, d.Get("vpn").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read ftd s2s vpn pre-shared key",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	versionSettings := ikeVersionSettings(item, d.Get("ike_version").(string))
+	// FMC does not return the key, keep the configured one unless the VPN was switched to automatic keys
+	preSharedKey := d.Get("pre_shared_key").(string)
+	if versionSettings.Authenticationtype == s2s_vpn_automatic_psk {
+		preSharedKey = ""
+	}
+	keyLength := versionSettings.Automaticpresharedkeylength
+	if keyLength == 0 {
+		keyLength = d.Get("automatic_key_length").(int)
+	}
+
+	for key, value := range map[string]interface{}{
+		"type":                 item.Type,
+		"authentication_type":  versionSettings.Authenticationtype,
+		"automatic_key_length": keyLength,
+		"pre_shared_key":       preSharedKey,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read ftd s2s vpn pre-shared key",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcFTDS2SVPNPSKUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("pre_shared_key", "automatic_key_length", "psk_rotation_trigger") {
+		_, err := updateFmcFTDS2SVPNPSK(ctx, c, d.Get("vpn").(string), d.Get("ike_version").(string), d.Get("pre_shared_key").(string), d.Get("automatic_key_length").(int))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update ftd s2s vpn pre-shared key",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcFTDS2SVPNPSKRead(ctx, d, m)
+}
+
+func resourceFmcFTDS2SVPNPSKDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The IKE settings cannot be deleted, so go back to automatically generated keys instead
+	_, err := updateFmcFTDS2SVPNPSK(ctx, c, d.Get("vpn").(string), d.Get("ike_version").(string), "", 24)
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete ftd s2s vpn pre-shared key",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
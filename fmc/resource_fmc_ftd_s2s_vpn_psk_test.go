@@ -0,0 +1,97 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcFTDS2SVPNPSKBasic(t *testing.T) {
+	vpn := "terraform-s2s-vpn"
+	trigger := "1"
+	triggerUpdated := "2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcFTDS2SVPNPSKDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcFTDS2SVPNPSKConfigBasic(vpn, trigger),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFTDS2SVPNPSKExists("fmc_ftd_s2s_vpn_psk.test", map[string]string{
+						"authentication_type":  s2s_vpn_manual_psk,
+						"psk_rotation_trigger": trigger,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcFTDS2SVPNPSKConfigBasic(vpn, triggerUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFTDS2SVPNPSKExists("fmc_ftd_s2s_vpn_psk.test", map[string]string{
+						"authentication_type":  s2s_vpn_manual_psk,
+						"psk_rotation_trigger": triggerUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcFTDS2SVPNPSKDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_ftd_s2s_vpn_psk" {
+			continue
+		}
+
+		settings, err := c.GetFmcFTDS2SVPNIKESettings(context.Background(), rs.Primary.Attributes["vpn"])
+		if err != nil {
+			return err
+		}
+		if authenticationType := ikeVersionSettings(settings, rs.Primary.Attributes["ike_version"]).Authenticationtype; authenticationType != s2s_vpn_automatic_psk {
+			return fmt.Errorf("pre-shared key was not reset: %s", authenticationType)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcFTDS2SVPNPSKConfigBasic(vpn, trigger string) string {
+	return fmt.Sprintf(`
+    data "fmc_ftd_s2s_vpns" "test" {
+        name = "%s"
+    }
+    resource "fmc_ftd_s2s_vpn_psk" "test" {
+        vpn                  = data.fmc_ftd_s2s_vpns.test.id
+        pre_shared_key       = "Terraform123!"
+        psk_rotation_trigger = "%s"
+    }
+    `, vpn, trigger)
+}
+
+func testAccCheckFmcFTDS2SVPNPSKExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,519 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ftd_snmp_settings_type string = "SNMPSetting"
+
+func resourceFmcFTDSNMP() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for SNMP settings of FTD Platform Settings Policies in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_ftd_snmp\" \"snmp\" {\n" +
+			"    platform_settings = data.fmc_ftd_platform_settings_policies.platform_settings.id\n" +
+			"    location = \"DC1\"\n" +
+			"    contact = \"noc@example.com\"\n" +
+			"    users {\n" +
+			"        username = \"monitoring\"\n" +
+			"        security_level = \"PRIV\"\n" +
+			"        auth_algorithm = \"SHA256\"\n" +
+			"        auth_password = var.snmp_auth_password\n" +
+			"        encryption_algorithm = \"AES256\"\n" +
+			"        encryption_password = var.snmp_encryption_password\n" +
+			"    }\n" +
+			"    hosts {\n" +
+			"        ip_address {\n" +
+			"            id = fmc_host_objects.nms.id\n" +
+			"            type = fmc_host_objects.nms.type\n" +
+			"        }\n" +
+			"        interfaces {\n" +
+			"            id = data.fmc_security_zones.inside.id\n" +
+			"            type = data.fmc_security_zones.inside.type\n" +
+			"        }\n" +
+			"        username = \"monitoring\"\n" +
+			"        trap = true\n" +
+			"    }\n" +
+			"    trap_link_up = true\n" +
+			"    trap_link_down = true\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** FMC does not return the SNMPv3 passwords, so changes to them outside of terraform are not detected. " +
+			"On destroy, SNMP is disabled and the users and hosts are removed from the platform settings policy.",
+		CreateContext: resourceFmcFTDSNMPCreate,
+		ReadContext:   resourceFmcFTDSNMPRead,
+		UpdateContext: resourceFmcFTDSNMPUpdate,
+		DeleteContext: resourceFmcFTDSNMPDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			// Values not known yet, e.g. passwords generated in the same apply, are not checked
+			missing := func(user map[string]interface{}, key string, i int) bool {
+				return user[key].(string) == "" && d.NewValueKnown(fmt.Sprintf("users.%d.%s", i, key))
+			}
+			for i, u := range d.Get("users").([]interface{}) {
+				user := u.(map[string]interface{})
+				securityLevel := strings.ToUpper(user["security_level"].(string))
+				if (securityLevel == "AUTH" || securityLevel == "PRIV") && (missing(user, "auth_algorithm", i) || missing(user, "auth_password", i)) {
+					return fmt.Errorf("auth_algorithm and auth_password are required for user %s with security_level %s", user["username"], securityLevel)
+				}
+				if securityLevel == "PRIV" && (missing(user, "encryption_algorithm", i) || missing(user, "encryption_password", i)) {
+					return fmt.Errorf("encryption_algorithm and encryption_password are required for user %s with security_level %s", user["username"], securityLevel)
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"platform_settings": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD platform settings policy this resource belongs to",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable the SNMP servers on the devices",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SNMP location of the devices",
+			},
+			"contact": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SNMP contact, the name of the system administrator",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     161,
+				Description: "UDP port on which the devices listen for SNMP requests",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the SNMPv3 user",
+						},
+						"security_level": {
+							Type:     schema.TypeString,
+							Required: true,
+							StateFunc: func(val interface{}) string {
+								return strings.ToUpper(val.(string))
+							},
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := strings.ToUpper(val.(string))
+								allowedValues := []string{"NOAUTH", "AUTH", "PRIV"}
+								for _, allowed := range allowedValues {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+								return
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+							Description: `Security level of the user, "NOAUTH", "AUTH" or "PRIV"`,
+						},
+						"auth_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							StateFunc: func(val interface{}) string {
+								return strings.ToUpper(val.(string))
+							},
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := strings.ToUpper(val.(string))
+								allowedValues := []string{"SHA", "SHA224", "SHA256", "SHA384"}
+								for _, allowed := range allowedValues {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+								return
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+							Description: `Authentication algorithm, "SHA", "SHA224", "SHA256" or "SHA384", required for the "AUTH" and "PRIV" security levels`,
+						},
+						"auth_password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Authentication password, required for the \"AUTH\" and \"PRIV\" security levels",
+						},
+						"encryption_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							StateFunc: func(val interface{}) string {
+								return strings.ToUpper(val.(string))
+							},
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := strings.ToUpper(val.(string))
+								allowedValues := []string{"AES128", "AES192", "AES256"}
+								for _, allowed := range allowedValues {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+								return
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+							Description: `Encryption algorithm, "AES128", "AES192" or "AES256", required for the "PRIV" security level`,
+						},
+						"encryption_password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Encryption password, required for the \"PRIV\" security level",
+						},
+					},
+				},
+				Description: "SNMPv3 users of this resource",
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+							Description: "Host object of the SNMP manager",
+						},
+						"interfaces": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+							Description: "Security zones or interface groups through which the SNMP manager is reached, the management interface if not set",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The SNMPv3 user used for this host",
+						},
+						"poll": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Allow the host to poll the devices",
+						},
+						"trap": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Send traps to the host",
+						},
+						"trap_port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     162,
+							Description: "UDP port of the host to send traps to",
+						},
+					},
+				},
+				Description: "SNMPv3 management hosts of this resource",
+			},
+			"trap_authentication": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Send traps for authentication failures",
+			},
+			"trap_link_up": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Send traps when a link comes up",
+			},
+			"trap_link_down": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Send traps when a link goes down",
+			},
+			"trap_cold_start": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Send traps on cold start",
+			},
+			"trap_warm_start": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Send traps on warm start",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandFTDPlatformSettingsSubConfigs(objs []interface{}) []FTDPlatformSettingsSubConfig {
+	subConfigs := []FTDPlatformSettingsSubConfig{}
+	for _, obj := range objs {
+		subConfig := obj.(map[string]interface{})
+		subConfigs = append(subConfigs, FTDPlatformSettingsSubConfig{
+			ID:   subConfig["id"].(string),
+			Type: subConfig["type"].(string),
+		})
+	}
+	return subConfigs
+}
+
+func expandFTDSNMPSettings(d *schema.ResourceData, id string) *FTDSNMPSettings {
+	users := []FTDSNMPUser{}
+	for _, u := range d.Get("users").([]interface{}) {
+		user := u.(map[string]interface{})
+		users = append(users, FTDSNMPUser{
+			Username:                    user["username"].(string),
+			Securitylevel:               strings.ToUpper(user["security_level"].(string)),
+			Authenticationalgorithmtype: strings.ToUpper(user["auth_algorithm"].(string)),
+			Authenticationpassword:      user["auth_password"].(string),
+			Encryptiontype:              strings.ToUpper(user["encryption_algorithm"].(string)),
+			Encryptionpassword:          user["encryption_password"].(string),
+		})
+	}
+
+	hosts := []FTDSNMPHost{}
+	for _, h := range d.Get("hosts").([]interface{}) {
+		host := h.(map[string]interface{})
+		hosts = append(hosts, FTDSNMPHost{
+			Ipaddress:   expandFTDPlatformSettingsSubConfigs(host["ip_address"].([]interface{}))[0],
+			Interfaces:  expandFTDPlatformSettingsSubConfigs(host["interfaces"].([]interface{})),
+			Snmpversion: "V3",
+			Username:    host["username"].(string),
+			Poll:        host["poll"].(bool),
+			Trap:        host["trap"].(bool),
+			Trapport:    host["trap_port"].(int),
+		})
+	}
+
+	return &FTDSNMPSettings{
+		ID:                      id,
+		Type:                    ftd_snmp_settings_type,
+		Enablesnmpservers:       d.Get("enabled").(bool),
+		Location:                d.Get("location").(string),
+		Systemadministratorname: d.Get("contact").(string),
+		Port:                    d.Get("port").(int),
+		Snmpusers:               users,
+		Snmpmgmthosts:           hosts,
+		Snmptraps: FTDSNMPTraps{
+			Authentication: d.Get("trap_authentication").(bool),
+			Linkup:         d.Get("trap_link_up").(bool),
+			Linkdown:       d.Get("trap_link_down").(bool),
+			Coldstart:      d.Get("trap_cold_start").(bool),
+			Warmstart:      d.Get("trap_warm_start").(bool),
+		},
+	}
+}
+
+func resourceFmcFTDSNMPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	policyID := d.Get("platform_settings").(string)
+	settings, err := c.GetFmcFTDSNMPSettings(ctx, policyID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ftd snmp settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_, err = c.UpdateFmcFTDSNMPSettings(ctx, policyID, settings.ID, expandFTDSNMPSettings(d, settings.ID))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ftd snmp settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(settings.ID)
+	return resourceFmcFTDSNMPRead(ctx, d, m)
+}
+
+func resourceFmcFTDSNMPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcFTDSNMPSettings(ctx, d.Get("platform_settings").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read ftd snmp settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// FMC does not return the passwords, keep the configured ones
+	passwords := map[string]map[string]interface{}{}
+	for _, u := range d.Get("users").([]interface{}) {
+		user := u.(map[string]interface{})
+		passwords[user["username"].(string)] = user
+	}
+	users := make([]interface{}, 0, len(item.Snmpusers))
+	for _, user := range item.Snmpusers {
+		authPassword, encryptionPassword := "", ""
+		if configured, ok := passwords[user.Username]; ok {
+			authPassword = configured["auth_password"].(string)
+			encryptionPassword = configured["encryption_password"].(string)
+		}
+		users = append(users, map[string]interface{}{
+			"username":             user.Username,
+			"security_level":       user.Securitylevel,
+			"auth_algorithm":       user.Authenticationalgorithmtype,
+			"auth_password":        authPassword,
+			"encryption_algorithm": user.Encryptiontype,
+			"encryption_password":  encryptionPassword,
+		})
+	}
+
+	hosts := make([]interface{}, 0, len(item.Snmpmgmthosts))
+	for _, host := range item.Snmpmgmthosts {
+		interfaces := make([]interface{}, 0, len(host.Interfaces))
+		for _, intf := range host.Interfaces {
+			interfaces = append(interfaces, map[string]interface{}{
+				"id":   intf.ID,
+				"type": intf.Type,
+			})
+		}
+		hosts = append(hosts, map[string]interface{}{
+			"ip_address": []interface{}{map[string]interface{}{
+				"id":   host.Ipaddress.ID,
+				"type": host.Ipaddress.Type,
+			}},
+			"interfaces": interfaces,
+			"username":   host.Username,
+			"poll":       host.Poll,
+			"trap":       host.Trap,
+			"trap_port":  host.Trapport,
+		})
+	}
+
+	for key, value := range map[string]interface{}{
+		"type":                item.Type,
+		"enabled":             item.Enablesnmpservers,
+		"location":            item.Location,
+		"contact":             item.Systemadministratorname,
+		"port":                item.Port,
+		"users":               users,
+		"hosts":               hosts,
+		"trap_authentication": item.Snmptraps.Authentication,
+		"trap_link_up":        item.Snmptraps.Linkup,
+		"trap_link_down":      item.Snmptraps.Linkdown,
+		"trap_cold_start":     item.Snmptraps.Coldstart,
+		"trap_warm_start":     item.Snmptraps.Warmstart,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read ftd snmp settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcFTDSNMPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("enabled", "location", "contact", "port", "users", "hosts", "trap_authentication", "trap_link_up", "trap_link_down", "trap_cold_start", "trap_warm_start") {
+		_, err := c.UpdateFmcFTDSNMPSettings(ctx, d.Get("platform_settings").(string), d.Id(), expandFTDSNMPSettings(d, d.Id()))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update ftd snmp settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcFTDSNMPRead(ctx, d, m)
+}
+
+func resourceFmcFTDSNMPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The SNMP settings cannot be deleted, so reset them instead
+	_, err := c.UpdateFmcFTDSNMPSettings(ctx, d.Get("platform_settings").(string), d.Id(), &FTDSNMPSettings{
+		ID:            d.Id(),
+		Type:          ftd_snmp_settings_type,
+		Port:          161,
+		Snmpusers:     []FTDSNMPUser{},
+		Snmpmgmthosts: []FTDSNMPHost{},
+	})
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete ftd snmp settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,118 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcFTDSNMPBasic(t *testing.T) {
+	policy := "FTD Platform Settings"
+	location := "DC1"
+	locationUpdated := "DC2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcFTDSNMPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcFTDSNMPConfigBasic(policy, location),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFTDSNMPExists("fmc_ftd_snmp.test", map[string]string{
+						"location":               location,
+						"users.0.security_level": "PRIV",
+						"hosts.0.username":       "terraform",
+						"trap_link_down":         "true",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcFTDSNMPConfigBasic(policy, locationUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFTDSNMPExists("fmc_ftd_snmp.test", map[string]string{
+						"location": locationUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcFTDSNMPDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_ftd_snmp" {
+			continue
+		}
+
+		settings, err := c.GetFmcFTDSNMPSettings(context.Background(), rs.Primary.Attributes["platform_settings"])
+		if err != nil {
+			return err
+		}
+		if settings.Enablesnmpservers || len(settings.Snmpusers) != 0 || len(settings.Snmpmgmthosts) != 0 {
+			return fmt.Errorf("SNMP settings were not reset: %+v", settings)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcFTDSNMPConfigBasic(policy, location string) string {
+	return fmt.Sprintf(`
+    data "fmc_ftd_platform_settings_policies" "test" {
+        name = "%s"
+    }
+    resource "fmc_host_objects" "test" {
+        name  = "terraform-snmp-host"
+        value = "10.10.20.5"
+    }
+    resource "fmc_ftd_snmp" "test" {
+        platform_settings = data.fmc_ftd_platform_settings_policies.test.id
+        location          = "%s"
+        users {
+            username             = "terraform"
+            security_level       = "PRIV"
+            auth_algorithm       = "SHA256"
+            auth_password        = "Terraform123!"
+            encryption_algorithm = "AES256"
+            encryption_password  = "Terraform123!"
+        }
+        hosts {
+            ip_address {
+                id   = fmc_host_objects.test.id
+                type = fmc_host_objects.test.type
+            }
+            username = "terraform"
+            trap     = true
+        }
+        trap_link_down = true
+    }
+    `, policy, location)
+}
+
+func testAccCheckFmcFTDSNMPExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
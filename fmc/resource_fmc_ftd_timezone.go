@@ -0,0 +1,176 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ftd_timezone_settings_type string = "TimeZoneSetting"
+
+var ftd_platform_settings_policy_type string = "FTDPlatformSettingsPolicy"
+
+func resourceFmcFTDTimezone() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for the timezone of FTD Platform Settings Policies in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_ftd_timezone\" \"timezone\" {\n" +
+			"    platform_settings = data.fmc_ftd_platform_settings_policies.platform_settings.id\n" +
+			"    timezone_object = fmc_timezone_objects.berlin.id\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The timezone applies to the devices the platform settings policy is assigned to, " +
+			"time ranges of access rules are evaluated in it. On destroy, the timezone is removed from the platform settings policy, so the devices use UTC.",
+		CreateContext: resourceFmcFTDTimezoneCreate,
+		ReadContext:   resourceFmcFTDTimezoneRead,
+		UpdateContext: resourceFmcFTDTimezoneUpdate,
+		DeleteContext: resourceFmcFTDTimezoneDelete,
+		Schema: map[string]*schema.Schema{
+			"platform_settings": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD platform settings policy",
+			},
+			"timezone_object": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the timezone object",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func resourceFmcFTDTimezoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	policyID := d.Get("platform_settings").(string)
+	settings, err := c.GetFmcFTDTimezoneSettings(ctx, policyID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ftd timezone settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	_, err = c.UpdateFmcFTDTimezoneSettings(ctx, policyID, settings.ID, &FTDTimezoneSettings{
+		ID:   settings.ID,
+		Type: ftd_timezone_settings_type,
+		Timezoneobject: &FTDPlatformSettingsSubConfig{
+			ID:   d.Get("timezone_object").(string),
+			Type: timezone_object_type,
+		},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create ftd timezone settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(settings.ID)
+	return resourceFmcFTDTimezoneRead(ctx, d, m)
+}
+
+func resourceFmcFTDTimezoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcFTDTimezoneSettings(ctx, d.Get("platform_settings").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read ftd timezone settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	timezoneObject := ""
+	if item.Timezoneobject != nil {
+		timezoneObject = item.Timezoneobject.ID
+	}
+
+	for key, value := range map[string]interface{}{
+		"type":            item.Type,
+		"timezone_object": timezoneObject,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read ftd timezone settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcFTDTimezoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("timezone_object") {
+		_, err := c.UpdateFmcFTDTimezoneSettings(ctx, d.Get("platform_settings").(string), d.Id(), &FTDTimezoneSettings{
+			ID:   d.Id(),
+			Type: ftd_timezone_settings_type,
+			Timezoneobject: &FTDPlatformSettingsSubConfig{
+				ID:   d.Get("timezone_object").(string),
+				Type: timezone_object_type,
+			},
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update ftd timezone settings",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcFTDTimezoneRead(ctx, d, m)
+}
+
+func resourceFmcFTDTimezoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The timezone settings cannot be deleted, so remove the timezone object instead
+	_, err := c.UpdateFmcFTDTimezoneSettings(ctx, d.Get("platform_settings").(string), d.Id(), &FTDTimezoneSettings{
+		ID:   d.Id(),
+		Type: ftd_timezone_settings_type,
+	})
+	if err != nil && !isNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete ftd timezone settings",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
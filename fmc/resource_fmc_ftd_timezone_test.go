@@ -0,0 +1,81 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcFTDTimezoneBasic(t *testing.T) {
+	policy := "FTD Platform Settings"
+	timezone := "Europe/Berlin"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcFTDTimezoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcFTDTimezoneConfigBasic(policy, timezone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcFTDTimezoneExists("fmc_ftd_timezone.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcFTDTimezoneDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_ftd_timezone" {
+			continue
+		}
+
+		settings, err := c.GetFmcFTDTimezoneSettings(context.Background(), rs.Primary.Attributes["platform_settings"])
+		if err != nil {
+			return err
+		}
+		if settings.Timezoneobject != nil && settings.Timezoneobject.ID != "" {
+			return fmt.Errorf("timezone settings were not reset: %+v", settings)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcFTDTimezoneConfigBasic(policy, timezone string) string {
+	return fmt.Sprintf(`
+    data "fmc_ftd_platform_settings_policies" "test" {
+        name = "%s"
+    }
+    resource "fmc_timezone_objects" "test" {
+        name     = "terraform-timezone"
+        timezone = "%s"
+    }
+    resource "fmc_ftd_timezone" "test" {
+        platform_settings = data.fmc_ftd_platform_settings_policies.test.id
+        timezone_object   = fmc_timezone_objects.test.id
+    }
+    `, policy, timezone)
+}
+
+func testAccCheckFmcFTDTimezoneExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		return nil
+	}
+}
@@ -21,21 +21,30 @@ func resourceFmcHostObjects() *schema.Resource {
 			"    value       = \"10.10.10.10\"\n" +
 			"    description = \"K8s primary\"\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** All the attributes are updated in place, so a host can be renumbered without replacing the rules and groups referencing it.\n" +
+			"\n" +
+			"**Note** Overridable objects can be given other values per device or domain in the FMC UI, the overrides are not managed by this resource.",
 		CreateContext: resourceFmcHostObjectsCreate,
 		ReadContext:   resourceFmcHostObjectsRead,
 		UpdateContext: resourceFmcHostObjectsUpdate,
 		DeleteContext: resourceFmcHostObjectsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The value of this resource",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIPValue(ip_value_host),
+				DiffSuppressFunc: suppressEquivalentIPValues,
+				Description:      "The IPv4 or IPv6 address of this resource, e.g. \"10.0.0.1\" or \"2001:db8::1\"",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -49,13 +58,13 @@ func resourceFmcHostObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
+			},
+			"overridable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the value of this resource can be overridden per device or domain, defaults to false",
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -74,8 +83,9 @@ func resourceFmcHostObjectsCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := c.CreateFmcHostObject(ctx, &HostObject{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Value:       d.Get("value").(string),
+		Overridable: d.Get("overridable").(bool),
 		Type:        host_type,
 	})
 	if err != nil {
@@ -132,6 +142,14 @@ func resourceFmcHostObjectsRead(ctx context.Context, d *schema.ResourceData, m i
 		})
 		return diags
 	}
+	if err := d.Set("overridable", item.Overridable); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read host object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
 	if err := d.Set("type", item.Type); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -147,11 +165,12 @@ func resourceFmcHostObjectsUpdate(ctx context.Context, d *schema.ResourceData, m
 	c := m.(*Client)
 	var diags diag.Diagnostics
 	id := d.Id()
-	if d.HasChanges("name", "description", "value") {
+	if d.HasChanges("name", "description", "value", "overridable") {
 		_, err := c.UpdateFmcHostObject(ctx, id, &HostObjectUpdateInput{
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Value:       d.Get("value").(string),
+			Overridable: d.Get("overridable").(bool),
 			Type:        host_type,
 			ID:          id,
 		})
@@ -176,7 +195,9 @@ func resourceFmcHostObjectsDelete(ctx context.Context, d *schema.ResourceData, m
 	id := d.Id()
 
 	err := c.DeleteFmcHostObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("host object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete host object",
@@ -26,6 +26,19 @@ func TestAccFmcHostObjectBasic(t *testing.T) {
 					testAccCheckFmcHostObjectExists("fmc_host_objects.test"),
 				),
 			},
+			{
+				Config: testAccCheckFmcHostObjectConfigOverridable(name, "2001:db8::1", description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcHostObjectExists("fmc_host_objects.test"),
+					resource.TestCheckResourceAttr("fmc_host_objects.test", "value", "2001:db8::1"),
+					resource.TestCheckResourceAttr("fmc_host_objects.test", "overridable", "true"),
+				),
+			},
+			{
+				ResourceName:      "fmc_host_objects.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -61,6 +74,17 @@ func testAccCheckFmcHostObjectConfigBasic(name, value, description string) strin
     `, name, value, description)
 }
 
+func testAccCheckFmcHostObjectConfigOverridable(name, value, description string) string {
+	return fmt.Sprintf(`
+    resource "fmc_host_objects" "test" {
+        name        = "%s"
+        value       = "%s"
+        description = "%s"
+        overridable = true
+    }
+    `, name, value, description)
+}
+
 func testAccCheckFmcHostObjectExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -28,9 +28,10 @@ func resourceFmcICMPV4Objects() *schema.Resource {
 		DeleteContext: resourceFmcICMPV4ObjectsDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"icmp_type": {
 				Type:        schema.TypeString,
@@ -171,7 +172,9 @@ func resourceFmcICMPV4ObjectsDelete(ctx context.Context, d *schema.ResourceData,
 	id := d.Id()
 
 	err := c.DeleteFmcICMPV4Object(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("icmpv4 object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete icmpv4 object",
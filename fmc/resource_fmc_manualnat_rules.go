@@ -12,6 +12,14 @@ import (
 
 var manualnat_rules_type string = "FTDManualNatRule"
 
+// validateIdentityNat checks that identity NAT rules are static, as FMC rejects dynamic ones
+func validateIdentityNat(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("identity_nat").(bool) && !strings.EqualFold(d.Get("nat_type").(string), "static") {
+		return fmt.Errorf("\"nat_type\" must be \"static\" for identity NAT, got: %q", d.Get("nat_type").(string))
+	}
+	return nil
+}
+
 func resourceFmcManualNatRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource for Manual NAT Rules in FMC\n" +
@@ -97,11 +105,15 @@ func resourceFmcManualNatRules() *schema.Resource {
 			"    ipv6 = true\n" +
 			"}\n" +
 			"```\n" +
-			"**Note** If creating multiple rules during a single `terraform apply`, remember to use `depends_on` to chain the rules so that terraform creates it in the same order that you intended.",
+			"**Note** If creating multiple rules during a single `terraform apply`, remember to use `depends_on` to chain the rules so that terraform creates it in the same order that you intended.\n" +
+			"\n" +
+			"**Note** With `identity_nat = true`, the translated source and destination and their ports are the original ones, e.g. to exempt VPN traffic from NAT. " +
+			"Consider setting `no_proxy_arp` and `perform_route_lookup` along with it.",
 		CreateContext: resourceFmcManualNatRulesCreate,
 		ReadContext:   resourceFmcManualNatRulesRead,
 		UpdateContext: resourceFmcManualNatRulesUpdate,
 		DeleteContext: resourceFmcManualNatRulesDelete,
+		CustomizeDiff: validateIdentityNat,
 		Schema: map[string]*schema.Schema{
 			"nat_policy": {
 				Type:        schema.TypeString,
@@ -163,13 +175,7 @@ func resourceFmcManualNatRules() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
@@ -414,6 +420,12 @@ func resourceFmcManualNatRules() *schema.Resource {
 				Optional:    true,
 				Description: "Make this resource unidirectional",
 			},
+			"identity_nat": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"translated_source", "translated_source_port", "translated_destination", "translated_destination_port", "interface_in_translated_source", "pat_options"},
+				Description:   "Translate the original source and destination to themselves, `nat_type` has to be \"static\"",
+			},
 			"fallthrough": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -512,6 +524,11 @@ func resourceFmcManualNatRulesCreate(ctx context.Context, d *schema.ResourceData
 			}
 		}
 	}
+	if d.Get("identity_nat").(bool) {
+		// Identity NAT translates the original source and destination to themselves
+		translatedSource, translatedSourcePort = originalSource, originalSourcePort
+		translatedDestination, translatedDestinationPort = originalDestination, originalDestinationPort
+	}
 	var patOptions *ManualNatRulePatOptions
 	if pat_o := d.Get("pat_options").([]interface{}); len(pat_o) > 0 {
 		pat_options := pat_o[0].(map[string]interface{})
@@ -530,7 +547,7 @@ func resourceFmcManualNatRulesCreate(ctx context.Context, d *schema.ResourceData
 	}
 	res, err := c.CreateFmcManualNatRule(ctx, d.Get("nat_policy").(string), strings.ToLower(d.Get("section").(string)), d.Get("target_index").(string), &ManualNatRule{
 		Type:                           manualnat_rules_type,
-		Description:                    d.Get("description").(string),
+		Description:                    c.withDescriptionMarker(d),
 		Enabled:                        d.Get("enabled").(bool),
 		Nattype:                        strings.ToUpper(d.Get("nat_type").(string)),
 		Sourceinterface:                sourceInterface,
@@ -599,6 +616,10 @@ func resourceFmcManualNatRulesRead(ctx context.Context, d *schema.ResourceData,
 	dynamicObjectNames := []string{"source_interface", "destination_interface", "original_destination", "original_destination_port", "original_source", "original_source_port", "translated_destination", "translated_destination_port", "translated_source", "translated_source_port"}
 
 	for i, objs := range dynamicObjects {
+		// The translated objects of identity NAT are not configured
+		if d.Get("identity_nat").(bool) && strings.HasPrefix(dynamicObjectNames[i], "translated_") {
+			continue
+		}
 		if *objs != (ManualNatRuleSubConfig{}) {
 			if err := d.Set(dynamicObjectNames[i], convertTo1ListMapStringGeneric(objs)); err != nil {
 				return returnWithDiag(diags, err)
@@ -614,7 +635,7 @@ func resourceFmcManualNatRulesUpdate(ctx context.Context, d *schema.ResourceData
 	// Warning or errors can be collected in a slice type
 	// var diags diag.Diagnostics
 	var diags diag.Diagnostics
-	if d.HasChanges("type", "description", "nat_type", "source_interface", "destination_interface", "original_destination", "original_destination_port", "original_source", "original_source_port", "translated_destination", "translated_destination_port", "translated_source", "translated_source_port", "unidirectional", "fallthrough", "translate_dns", "no_proxy_arp", "perform_route_lookup", "net_to_net", "ipv6", "pat_options") {
+	if d.HasChanges("type", "description", "nat_type", "source_interface", "destination_interface", "original_destination", "original_destination_port", "original_source", "original_source_port", "translated_destination", "translated_destination_port", "translated_source", "translated_source_port", "identity_nat", "unidirectional", "fallthrough", "translate_dns", "no_proxy_arp", "perform_route_lookup", "net_to_net", "ipv6", "pat_options") {
 		var sourceInterface, destinationInterface, originalDestination, originalDestinationPort, originalSource, originalSourcePort, translatedDestination, translatedDestinationPort, translatedSource, translatedSourcePort *ManualNatRuleSubConfig
 		dynamicObjects := []**ManualNatRuleSubConfig{
 			&sourceInterface, &destinationInterface, &originalDestination, &originalDestinationPort, &originalSource, &originalSourcePort, &translatedDestination, &translatedDestinationPort, &translatedSource, &translatedSourcePort,
@@ -628,6 +649,11 @@ func resourceFmcManualNatRulesUpdate(ctx context.Context, d *schema.ResourceData
 				}
 			}
 		}
+		if d.Get("identity_nat").(bool) {
+			// Identity NAT translates the original source and destination to themselves
+			translatedSource, translatedSourcePort = originalSource, originalSourcePort
+			translatedDestination, translatedDestinationPort = originalDestination, originalDestinationPort
+		}
 		var patOptions *ManualNatRulePatOptions
 		if pat_o := d.Get("pat_options").([]interface{}); len(pat_o) > 0 {
 			pat_options := pat_o[0].(map[string]interface{})
@@ -647,7 +673,7 @@ func resourceFmcManualNatRulesUpdate(ctx context.Context, d *schema.ResourceData
 		res, err := c.UpdateFmcManualNatRule(ctx, d.Get("nat_policy").(string), d.Id(), &ManualNatRule{
 			ID:                             d.Id(),
 			Type:                           manualnat_rules_type,
-			Description:                    d.Get("description").(string),
+			Description:                    c.withDescriptionMarker(d),
 			Enabled:                        d.Get("enabled").(bool),
 			Nattype:                        strings.ToUpper(d.Get("nat_type").(string)),
 			Sourceinterface:                sourceInterface,
@@ -686,7 +712,9 @@ func resourceFmcManualNatRulesDelete(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	err := c.DeleteFmcManualNatRule(ctx, d.Get("nat_policy").(string), d.Id())
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("manual nat rule", err))
+	} else if err != nil {
 		return returnWithDiag(diags, err)
 	}
 
@@ -29,6 +29,27 @@ func TestAccFmcManualNatRuleBasic(t *testing.T) {
 	})
 }
 
+func TestAccFmcManualNatRuleIdentity(t *testing.T) {
+	name := "Test NAT Rule Policy"
+	description := "test identity manualnat rule"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcManualNatRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcManualNatRuleConfigIdentity(name, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcManualNatRuleExists("fmc_ftd_manualnat_rules.test"),
+					resource.TestCheckResourceAttr("fmc_ftd_manualnat_rules.test", "identity_nat", "true"),
+					resource.TestCheckNoResourceAttr("fmc_ftd_manualnat_rules.test", "translated_source.0.id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFmcManualNatRuleDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 
@@ -79,6 +100,34 @@ func testAccCheckFmcManualNatRuleConfigBasic(name, description string) string {
     `, name, description)
 }
 
+func testAccCheckFmcManualNatRuleConfigIdentity(name, description string) string {
+	return fmt.Sprintf(`
+	resource "fmc_network_objects" "test" {
+        name        = "test_manual_nat_network_obj"
+        value       = "10.10.10.0/24"
+        description = "Testing"
+    }
+
+    resource "fmc_ftd_nat_policies" "nat_policy" {
+		name = "%s"
+		description = "Test NAT policy!"
+	}
+
+	resource "fmc_ftd_manualnat_rules" "test" {
+		nat_policy = fmc_ftd_nat_policies.nat_policy.id
+		description = "%s"
+		nat_type = "static"
+		identity_nat = true
+		original_source {
+			id = fmc_network_objects.test.id
+			type = fmc_network_objects.test.type
+		}
+		no_proxy_arp = true
+		perform_route_lookup = true
+	}
+    `, name, description)
+}
+
 func testAccCheckFmcManualNatRuleExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -27,9 +27,10 @@ func resourceFmcNatPolicies() *schema.Resource {
 		DeleteContext: resourceFmcNatPoliciesDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_policy_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -43,13 +44,7 @@ func resourceFmcNatPolicies() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -68,7 +63,7 @@ func resourceFmcNatPoliciesCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := c.CreateFmcNatPolicy(ctx, &NatPolicy{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Type:        nat_policy_type,
 	})
 	if err != nil {
@@ -137,7 +132,7 @@ func resourceFmcNatPoliciesUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := c.UpdateFmcNatPolicy(ctx, d.Id(), &NatPolicy{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Type:        nat_policy_type,
 		ID:          d.Id(),
 	})
@@ -161,7 +156,10 @@ func resourceFmcNatPoliciesDelete(ctx context.Context, d *schema.ResourceData, m
 
 	id := d.Id()
 
-	if err := c.DeleteFmcNatPolicy(ctx, id); err != nil {
+	err := c.DeleteFmcNatPolicy(ctx, id)
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("nat policy", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete nat policy",
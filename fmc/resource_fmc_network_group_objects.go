@@ -2,6 +2,9 @@ package fmc
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,6 +12,9 @@ import (
 
 var network_group_type string = "NetworkGroup"
 
+// The types of the objects in network groups, groups can be nested in groups
+var network_group_member_types = []string{"Network", "Host", "Range", "FQDN", "NetworkGroup"}
+
 func resourceFmcNetworkGroupObjects() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource for Network Group Objects in FMC\n" +
@@ -32,16 +38,21 @@ func resourceFmcNetworkGroupObjects() *schema.Resource {
 			"      type = \"Host\"\n" +
 			"  }\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** Groups can be nested by adding them to `objects` with the type `NetworkGroup`. " +
+			"The objects and literals are read back in the configured order, so FMC returning them in another order does not show as drift. " +
+			"FMC has no API to add or remove single members, so updates send the whole membership, " +
+			"each member is sent once with only its ID or value and updates which only reorder the members are skipped.",
 		CreateContext: resourceFmcNetworkGroupObjectsCreate,
 		ReadContext:   resourceFmcNetworkGroupObjectsRead,
 		UpdateContext: resourceFmcNetworkGroupObjectsUpdate,
 		DeleteContext: resourceFmcNetworkGroupObjectsDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -55,13 +66,7 @@ func resourceFmcNetworkGroupObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -79,13 +84,23 @@ func resourceFmcNetworkGroupObjects() *schema.Resource {
 							Description: "The ID of this resource",
 						},
 						"type": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The type of this resource",
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								for _, allowed := range network_group_member_types {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, network_group_member_types, v))
+								return
+							},
+							Description: `The type of this resource, "Network", "Host", "Range", "FQDN" or "NetworkGroup"`,
 						},
 					},
 				},
-				Description: "List of network objects to add",
+				Description: "List of network objects and nested network groups to add",
 			},
 			"literals": {
 				Type:     schema.TypeList,
@@ -93,9 +108,10 @@ func resourceFmcNetworkGroupObjects() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"value": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The value of this resource",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressEquivalentIPValues,
+							Description:      "The IPv4 or IPv6 value of this resource",
 						},
 						"type": {
 							Type:        schema.TypeString,
@@ -116,32 +132,11 @@ func resourceFmcNetworkGroupObjectsCreate(ctx context.Context, d *schema.Resourc
 	// var diags diag.Diagnostics
 	var diags diag.Diagnostics
 
-	var objs []NetworkGroupObjectObjects
-	var lits []NetworkGroupObjectLiterals
-
-	if inputObjs, ok := d.GetOk("objects"); ok {
-		for _, obj := range inputObjs.([]interface{}) {
-			obji := obj.(map[string]interface{})
-			objs = append(objs, NetworkGroupObjectObjects{
-				ID:   obji["id"].(string),
-				Type: obji["type"].(string),
-			})
-		}
-	}
-
-	if inputLits, ok := d.GetOk("literals"); ok {
-		for _, lit := range inputLits.([]interface{}) {
-			liti := lit.(map[string]interface{})
-			lits = append(lits, NetworkGroupObjectLiterals{
-				Value: liti["value"].(string),
-				Type:  liti["type"].(string),
-			})
-		}
-	}
+	objs, lits := networkGroupMembers(d)
 
 	res, err := c.CreateFmcNetworkGroupObject(ctx, &NetworkGroupObject{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Type:        network_group_type,
 		Objects:     objs,
 		Literals:    lits,
@@ -208,6 +203,9 @@ func resourceFmcNetworkGroupObjectsRead(ctx context.Context, d *schema.ResourceD
 		obji["type"] = obj.Type
 		objects = append(objects, obji)
 	}
+	objects = orderLikeState(d.Get("objects").([]interface{}), objects, func(state, read map[string]interface{}) bool {
+		return state["id"] == read["id"]
+	})
 
 	if err := d.Set("objects", objects); err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -225,6 +223,9 @@ func resourceFmcNetworkGroupObjectsRead(ctx context.Context, d *schema.ResourceD
 		liti["type"] = lit.Type
 		literals = append(literals, liti)
 	}
+	literals = orderLikeState(d.Get("literals").([]interface{}), literals, func(state, read map[string]interface{}) bool {
+		return state["type"] == read["type"] && equivalentIPValues(state["value"].(string), read["value"].(string))
+	})
 
 	if err := d.Set("literals", literals); err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -242,34 +243,17 @@ func resourceFmcNetworkGroupObjectsUpdate(ctx context.Context, d *schema.Resourc
 	c := m.(*Client)
 	var diags diag.Diagnostics
 	id := d.Id()
-	if d.HasChanges("name", "description", "objects", "literals") {
-		var objs []NetworkGroupObjectObjects
-		var lits []NetworkGroupObjectLiterals
-
-		if inputObjs, ok := d.GetOk("objects"); ok {
-			for _, obj := range inputObjs.([]interface{}) {
-				obji := obj.(map[string]interface{})
-				objs = append(objs, NetworkGroupObjectObjects{
-					ID:   obji["id"].(string),
-					Name: obji["name"].(string),
-					Type: obji["type"].(string),
-				})
-			}
-		}
-
-		if inputLits, ok := d.GetOk("literals"); ok {
-			for _, lit := range inputLits.([]interface{}) {
-				liti := lit.(map[string]interface{})
-				lits = append(lits, NetworkGroupObjectLiterals{
-					Value: liti["value"].(string),
-					Type:  liti["type"].(string),
-				})
-			}
-		}
+	oldObjs, newObjs := d.GetChange("objects")
+	oldLits, newLits := d.GetChange("literals")
+	addedObjs, removedObjs := networkGroupMembershipDelta(oldObjs.([]interface{}), newObjs.([]interface{}), networkGroupObjectKey)
+	addedLits, removedLits := networkGroupMembershipDelta(oldLits.([]interface{}), newLits.([]interface{}), networkGroupLiteralKey)
+	if d.HasChanges("name", "description") || addedObjs+removedObjs+addedLits+removedLits > 0 {
+		log.Printf("[DEBUG] updating network group object %s: adding %d and removing %d objects, adding %d and removing %d literals", id, addedObjs, removedObjs, addedLits, removedLits)
+		objs, lits := networkGroupMembers(d)
 		_, err := c.UpdateFmcNetworkGroupObject(ctx, id, &NetworkGroupObjectUpdateInput{
 			ID:          d.Id(),
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Type:        network_group_type,
 			Objects:     objs,
 			Literals:    lits,
@@ -287,6 +271,69 @@ func resourceFmcNetworkGroupObjectsUpdate(ctx context.Context, d *schema.Resourc
 	return resourceFmcNetworkGroupObjectsRead(ctx, d, m)
 }
 
+// networkGroupMembers returns the objects and literals of the group to send to FMC. Each member is sent once and
+// with only the fields FMC needs, to keep the payload of large groups small.
+func networkGroupMembers(d *schema.ResourceData) ([]NetworkGroupObjectObjects, []NetworkGroupObjectLiterals) {
+	var objs []NetworkGroupObjectObjects
+	var lits []NetworkGroupObjectLiterals
+
+	seen := map[string]bool{}
+	for _, obj := range d.Get("objects").([]interface{}) {
+		obji := obj.(map[string]interface{})
+		if key := networkGroupObjectKey(obji); !seen[key] {
+			seen[key] = true
+			objs = append(objs, NetworkGroupObjectObjects{
+				ID:   obji["id"].(string),
+				Type: obji["type"].(string),
+			})
+		}
+	}
+
+	for _, lit := range d.Get("literals").([]interface{}) {
+		liti := lit.(map[string]interface{})
+		if key := networkGroupLiteralKey(liti); !seen[key] {
+			seen[key] = true
+			lits = append(lits, NetworkGroupObjectLiterals{
+				Value: liti["value"].(string),
+				Type:  liti["type"].(string),
+			})
+		}
+	}
+	return objs, lits
+}
+
+func networkGroupObjectKey(obj map[string]interface{}) string {
+	return "object/" + obj["id"].(string)
+}
+
+func networkGroupLiteralKey(lit map[string]interface{}) string {
+	return "literal/" + lit["type"].(string) + "/" + strings.ToLower(lit["value"].(string))
+}
+
+// networkGroupMembershipDelta returns how many members are added to and removed from the group, members which
+// only moved are neither
+func networkGroupMembershipDelta(old, new []interface{}, key func(map[string]interface{}) string) (added, removed int) {
+	oldKeys := map[string]bool{}
+	for _, member := range old {
+		oldKeys[key(member.(map[string]interface{}))] = true
+	}
+	newKeys := map[string]bool{}
+	for _, member := range new {
+		newKeys[key(member.(map[string]interface{}))] = true
+	}
+	for k := range newKeys {
+		if !oldKeys[k] {
+			added++
+		}
+	}
+	for k := range oldKeys {
+		if !newKeys[k] {
+			removed++
+		}
+	}
+	return
+}
+
 func resourceFmcNetworkGroupObjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 
@@ -296,7 +343,9 @@ func resourceFmcNetworkGroupObjectsDelete(ctx context.Context, d *schema.Resourc
 	id := d.Id()
 
 	err := c.DeleteFmcNetworkGroupObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("network group object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete network group object",
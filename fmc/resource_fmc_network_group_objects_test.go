@@ -2,11 +2,13 @@ package fmc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -31,6 +33,25 @@ func TestAccFmcNetworkGroupObjectBasic(t *testing.T) {
 	})
 }
 
+func TestAccFmcNetworkGroupObjectNested(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcNetworkGroupObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcNetworkGroupObjectConfigNested("1.1.1.0/24", "test_network_group_inner", "test_network_group_outer"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcNetworkGroupObjectExists("fmc_network_group_objects.outer"),
+					resource.TestCheckResourceAttrPair("fmc_network_group_objects.outer", "objects.0.id", "fmc_network_group_objects.inner", "id"),
+					resource.TestCheckResourceAttr("fmc_network_group_objects.outer", "objects.0.type", "NetworkGroup"),
+					resource.TestCheckResourceAttr("fmc_network_group_objects.outer", "literals.0.value", "10.0.0.0/8"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFmcNetworkGroupObjectDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*Client)
 
@@ -82,6 +103,35 @@ func testAccCheckFmcNetworkGroupObjectConfigBasic(net1, net2, name, literal stri
     `, net1, net2, name, literal)
 }
 
+func testAccCheckFmcNetworkGroupObjectConfigNested(net, inner, outer string) string {
+	return fmt.Sprintf(`
+	resource "fmc_network_objects" "test" {
+		name  = "test_nested"
+		value = "%s"
+	}
+
+	resource "fmc_network_group_objects" "inner" {
+		name = "%s"
+		objects {
+			id   = fmc_network_objects.test.id
+			type = fmc_network_objects.test.type
+		}
+	}
+
+	resource "fmc_network_group_objects" "outer" {
+		name = "%s"
+		objects {
+			id   = fmc_network_group_objects.inner.id
+			type = fmc_network_group_objects.inner.type
+		}
+		literals {
+			value = "10.0.0.0/8"
+			type  = "Network"
+		}
+	}
+    `, net, inner, outer)
+}
+
 func testAccCheckFmcNetworkGroupObjectExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -97,3 +147,51 @@ func testAccCheckFmcNetworkGroupObjectExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestNetworkGroupMembers(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFmcNetworkGroupObjects().Schema, map[string]interface{}{
+		"name": "group",
+		"objects": []interface{}{
+			map[string]interface{}{"id": "1", "type": "Network"},
+			map[string]interface{}{"id": "2", "type": "Host"},
+			map[string]interface{}{"id": "1", "type": "Network"},
+		},
+		"literals": []interface{}{
+			map[string]interface{}{"value": "10.0.0.1", "type": "Host"},
+			map[string]interface{}{"value": "10.0.0.1", "type": "Host"},
+		},
+	})
+	objs, lits := networkGroupMembers(d)
+	body, err := json.Marshal(&NetworkGroupObjectUpdateInput{Objects: objs, Literals: lits})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`"objects":[{"type":"Network","id":"1"},{"type":"Host","id":"2"}]`,
+		`"literals":[{"type":"Host","value":"10.0.0.1"}]`,
+	} {
+		if !strings.Contains(string(body), expected) {
+			t.Errorf("expected %s in %s", expected, body)
+		}
+	}
+}
+
+func TestNetworkGroupMembershipDelta(t *testing.T) {
+	member := func(id string) interface{} {
+		return map[string]interface{}{"id": id, "type": "Network"}
+	}
+	for _, test := range []struct {
+		old, new       []interface{}
+		added, removed int
+	}{
+		{[]interface{}{member("1"), member("2")}, []interface{}{member("2"), member("1")}, 0, 0},
+		{[]interface{}{member("1")}, []interface{}{member("1"), member("2"), member("3")}, 2, 0},
+		{[]interface{}{member("1"), member("2")}, []interface{}{member("3")}, 1, 2},
+		{nil, []interface{}{member("1")}, 1, 0},
+	} {
+		added, removed := networkGroupMembershipDelta(test.old, test.new, networkGroupObjectKey)
+		if added != test.added || removed != test.removed {
+			t.Errorf("expected %d added and %d removed, got %d and %d", test.added, test.removed, added, removed)
+		}
+	}
+}
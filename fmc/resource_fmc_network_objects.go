@@ -2,6 +2,8 @@ package fmc
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,21 +23,30 @@ func resourceFmcNetworkObjects() *schema.Resource {
 			"  value       = \"10.10.10.0/24\"\n" +
 			"  description = \"Terraform DR network object\"\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** Overridable objects can be given other values per device or domain in the FMC UI, the overrides are not managed by this resource.\n" +
+			"\n" +
+			"**Note** Existing objects can be imported either by ID or by name, e.g. `terraform import fmc_network_objects.PrivateVLANDR name=VLAN-Private-DRsite`.",
 		CreateContext: resourceFmcNetworkObjectsCreate,
 		ReadContext:   resourceFmcNetworkObjectsRead,
 		UpdateContext: resourceFmcNetworkObjectsUpdate,
 		DeleteContext: resourceFmcNetworkObjectsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFmcNetworkObjectsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The value of this resource",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIPValue(ip_value_network),
+				DiffSuppressFunc: suppressEquivalentIPValues,
+				Description:      "The IPv4 or IPv6 network of this resource, e.g. \"10.0.0.0/8\" or \"2001:db8::/32\"",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -49,13 +60,13 @@ func resourceFmcNetworkObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
+			},
+			"overridable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the value of this resource can be overridden per device or domain, defaults to false",
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -74,8 +85,9 @@ func resourceFmcNetworkObjectsCreate(ctx context.Context, d *schema.ResourceData
 
 	res, err := c.CreateFmcNetworkObject(ctx, &NetworkObject{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Value:       d.Get("value").(string),
+		Overridable: d.Get("overridable").(bool),
 		Type:        network_type,
 	})
 	if err != nil {
@@ -133,6 +145,15 @@ func resourceFmcNetworkObjectsRead(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
+	if err := d.Set("overridable", item.Overridable); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read network object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	if err := d.Set("type", item.Type); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -149,11 +170,12 @@ func resourceFmcNetworkObjectsUpdate(ctx context.Context, d *schema.ResourceData
 	c := m.(*Client)
 	var diags diag.Diagnostics
 	id := d.Id()
-	if d.HasChanges("name", "description", "value") {
+	if d.HasChanges("name", "description", "value", "overridable") {
 		_, err := c.UpdateFmcNetworkObject(ctx, id, &NetworkObjectUpdateInput{
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Value:       d.Get("value").(string),
+			Overridable: d.Get("overridable").(bool),
 			Type:        network_type,
 			ID:          id,
 		})
@@ -178,7 +200,9 @@ func resourceFmcNetworkObjectsDelete(ctx context.Context, d *schema.ResourceData
 	id := d.Id()
 
 	err := c.DeleteFmcNetworkObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("network object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete network object",
@@ -193,3 +217,24 @@ func resourceFmcNetworkObjectsDelete(ctx context.Context, d *schema.ResourceData
 
 	return diags
 }
+
+// resourceFmcNetworkObjectsImport accepts either the ID of the object or "name=<object name>", in which
+// case the ID is looked up so that objects can be imported without first collecting their IDs
+func resourceFmcNetworkObjectsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*Client)
+
+	if !strings.HasPrefix(d.Id(), "name=") {
+		return []*schema.ResourceData{d}, nil
+	}
+	name := strings.TrimPrefix(d.Id(), "name=")
+	item, err := c.GetFmcNetworkObjectByNameOrValue(ctx, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import network object %s: %s", name, err.Error())
+	}
+	// The lookup also matches on the value, only accept an exact name match
+	if item.Name != name {
+		return nil, fmt.Errorf("unable to import network object %s: no network object found with this name", name)
+	}
+	d.SetId(item.ID)
+	return []*schema.ResourceData{d}, nil
+}
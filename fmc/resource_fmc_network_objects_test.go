@@ -24,8 +24,27 @@ func TestAccFmcNetworkObjectBasic(t *testing.T) {
 				Config: testAccCheckFmcNetworkObjectConfigBasic(name, value, description),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFmcNetworkObjectExists("fmc_network_objects.test"),
+					resource.TestCheckResourceAttr("fmc_network_objects.test", "overridable", "false"),
 				),
 			},
+			{
+				Config: testAccCheckFmcNetworkObjectConfigOverridable(name, value, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcNetworkObjectExists("fmc_network_objects.test"),
+					resource.TestCheckResourceAttr("fmc_network_objects.test", "overridable", "true"),
+				),
+			},
+			{
+				ResourceName:      "fmc_network_objects.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "fmc_network_objects.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("name=%s", name),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -61,6 +80,17 @@ func testAccCheckFmcNetworkObjectConfigBasic(name, value, description string) st
     `, name, value, description)
 }
 
+func testAccCheckFmcNetworkObjectConfigOverridable(name, value, description string) string {
+	return fmt.Sprintf(`
+    resource "fmc_network_objects" "test" {
+        name        = "%s"
+        value       = "%s"
+        description = "%s"
+        overridable = true
+    }
+    `, name, value, description)
+}
+
 func testAccCheckFmcNetworkObjectExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
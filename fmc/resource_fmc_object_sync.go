@@ -0,0 +1,469 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// objectSyncer mirrors one kind of named object from the source FMC to the target FMC
+type objectSyncer struct {
+	key        string
+	objectType string
+	// sync creates or updates the object on the target, and returns the source ID, target ID and value
+	sync   func(ctx context.Context, source, target *Client, name string) (string, string, string, error)
+	get    func(ctx context.Context, target *Client, id string) error
+	delete func(ctx context.Context, target *Client, id string) error
+}
+
+var object_syncers = []objectSyncer{
+	{
+		key:        "network_objects",
+		objectType: "Network",
+		sync: func(ctx context.Context, source, target *Client, name string) (string, string, string, error) {
+			src, err := source.GetFmcNetworkObjectByNameOrValue(ctx, name, nil)
+			if err != nil || src.Name != name {
+				return "", "", "", fmt.Errorf("no network object named %s found on the source FMC: %v", name, err)
+			}
+			dst, err := target.GetFmcNetworkObjectByNameOrValue(ctx, name, nil)
+			if err == nil && dst.Name == name {
+				if dst.Value != src.Value || dst.Description != src.Description || dst.Overridable != src.Overridable {
+					dst, err = target.UpdateFmcNetworkObject(ctx, dst.ID, &NetworkObjectUpdateInput{
+						ID:          dst.ID,
+						Name:        name,
+						Value:       src.Value,
+						Description: src.Description,
+						Overridable: src.Overridable,
+						Type:        network_type,
+					})
+				}
+			} else {
+				dst, err = target.CreateFmcNetworkObject(ctx, &NetworkObject{
+					Name:        name,
+					Value:       src.Value,
+					Description: src.Description,
+					Overridable: src.Overridable,
+					Type:        network_type,
+				})
+			}
+			if err != nil {
+				return "", "", "", err
+			}
+			return src.ID, dst.ID, src.Value, nil
+		},
+		get: func(ctx context.Context, target *Client, id string) error {
+			_, err := target.GetFmcNetworkObject(ctx, id)
+			return err
+		},
+		delete: func(ctx context.Context, target *Client, id string) error {
+			return target.DeleteFmcNetworkObject(ctx, id)
+		},
+	},
+	{
+		key:        "host_objects",
+		objectType: "Host",
+		sync: func(ctx context.Context, source, target *Client, name string) (string, string, string, error) {
+			src, err := source.GetFmcHostObjectByNameOrValue(ctx, name, nil)
+			if err != nil || src.Name != name {
+				return "", "", "", fmt.Errorf("no host object named %s found on the source FMC: %v", name, err)
+			}
+			dst, err := target.GetFmcHostObjectByNameOrValue(ctx, name, nil)
+			if err == nil && dst.Name == name {
+				if dst.Value != src.Value || dst.Description != src.Description || dst.Overridable != src.Overridable {
+					dst, err = target.UpdateFmcHostObject(ctx, dst.ID, &HostObjectUpdateInput{
+						ID:          dst.ID,
+						Name:        name,
+						Value:       src.Value,
+						Description: src.Description,
+						Overridable: src.Overridable,
+						Type:        host_type,
+					})
+				}
+			} else {
+				dst, err = target.CreateFmcHostObject(ctx, &HostObject{
+					Name:        name,
+					Value:       src.Value,
+					Description: src.Description,
+					Overridable: src.Overridable,
+					Type:        host_type,
+				})
+			}
+			if err != nil {
+				return "", "", "", err
+			}
+			return src.ID, dst.ID, src.Value, nil
+		},
+		get: func(ctx context.Context, target *Client, id string) error {
+			_, err := target.GetFmcHostObject(ctx, id)
+			return err
+		},
+		delete: func(ctx context.Context, target *Client, id string) error {
+			return target.DeleteFmcHostObject(ctx, id)
+		},
+	},
+	{
+		key:        "range_objects",
+		objectType: "Range",
+		sync: func(ctx context.Context, source, target *Client, name string) (string, string, string, error) {
+			src, err := source.GetFmcRangeObjectByNameOrValue(ctx, name, nil)
+			if err != nil || src.Name != name {
+				return "", "", "", fmt.Errorf("no range object named %s found on the source FMC: %v", name, err)
+			}
+			dst, err := target.GetFmcRangeObjectByNameOrValue(ctx, name, nil)
+			if err == nil && dst.Name == name {
+				if dst.Value != src.Value || dst.Description != src.Description || dst.Overridable != src.Overridable {
+					dst, err = target.UpdateFmcRangeObject(ctx, dst.ID, &RangeObjectUpdateInput{
+						ID:          dst.ID,
+						Name:        name,
+						Value:       src.Value,
+						Description: src.Description,
+						Overridable: src.Overridable,
+						Type:        range_type,
+					})
+				}
+			} else {
+				dst, err = target.CreateFmcRangeObject(ctx, &RangeObject{
+					Name:        name,
+					Value:       src.Value,
+					Description: src.Description,
+					Overridable: src.Overridable,
+					Type:        range_type,
+				})
+			}
+			if err != nil {
+				return "", "", "", err
+			}
+			return src.ID, dst.ID, src.Value, nil
+		},
+		get: func(ctx context.Context, target *Client, id string) error {
+			_, err := target.GetFmcRangeObject(ctx, id)
+			return err
+		},
+		delete: func(ctx context.Context, target *Client, id string) error {
+			return target.DeleteFmcRangeObject(ctx, id)
+		},
+	},
+	{
+		key:        "fqdn_objects",
+		objectType: "FQDN",
+		sync: func(ctx context.Context, source, target *Client, name string) (string, string, string, error) {
+			src, err := source.GetFmcFQDNObjectByNameOrValue(ctx, name, nil)
+			if err != nil || src.Name != name {
+				return "", "", "", fmt.Errorf("no fqdn object named %s found on the source FMC: %v", name, err)
+			}
+			dst, err := target.GetFmcFQDNObjectByNameOrValue(ctx, name, nil)
+			if err == nil && dst.Name == name {
+				if dst.Value != src.Value || dst.Description != src.Description || dst.DNSResolution != src.DNSResolution {
+					dst, err = target.UpdateFmcFQDNObject(ctx, dst.ID, &FQDNObjectUpdateInput{
+						ID:            dst.ID,
+						Name:          name,
+						Value:         src.Value,
+						Description:   src.Description,
+						DNSResolution: src.DNSResolution,
+						Type:          fqdn_type,
+					})
+				}
+			} else {
+				dst, err = target.CreateFmcFQDNObject(ctx, &FQDNObject{
+					Name:          name,
+					Value:         src.Value,
+					Description:   src.Description,
+					DNSResolution: src.DNSResolution,
+					Type:          fqdn_type,
+				})
+			}
+			if err != nil {
+				return "", "", "", err
+			}
+			return src.ID, dst.ID, src.Value, nil
+		},
+		get: func(ctx context.Context, target *Client, id string) error {
+			_, err := target.GetFmcFQDNObject(ctx, id)
+			return err
+		},
+		delete: func(ctx context.Context, target *Client, id string) error {
+			return target.DeleteFmcFQDNObject(ctx, id)
+		},
+	},
+}
+
+func resourceFmcObjectSync() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for mirroring named objects from a source FMC to the FMC of this provider\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"provider \"fmc\" {\n" +
+			"    alias = \"branch\"\n" +
+			"    fmc_host = var.branch_fmc_host\n" +
+			"    fmc_username = var.branch_fmc_username\n" +
+			"    fmc_password = var.branch_fmc_password\n" +
+			"}\n" +
+			"\n" +
+			"resource \"fmc_object_sync\" \"branch\" {\n" +
+			"    provider = fmc.branch\n" +
+			"    source {\n" +
+			"        fmc_host = var.fmc_host\n" +
+			"        fmc_username = var.fmc_username\n" +
+			"        fmc_password = var.fmc_password\n" +
+			"    }\n" +
+			"    network_objects = [\"VLAN825-Private\"]\n" +
+			"    host_objects = [\"CUCM-Pub\"]\n" +
+			"    fqdn_objects = [\"Office365\"]\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The objects are looked up by name on the source FMC, and created or updated by name on the target FMC. " +
+			"The target FMC is the one configured for the provider of this resource, so use a provider alias to sync to a second FMC. " +
+			"For objects which need changes on the way, read them with the data sources from the source provider alias and write them with the resources through the target alias instead.",
+		CreateContext: resourceFmcObjectSyncCreate,
+		ReadContext:   resourceFmcObjectSyncRead,
+		UpdateContext: resourceFmcObjectSyncUpdate,
+		DeleteContext: resourceFmcObjectSyncDelete,
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fmc_host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Hostname/IP address of the source FMC",
+						},
+						"fmc_username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Username for the user to login to the source FMC",
+						},
+						"fmc_password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for the user to login to the source FMC",
+						},
+						"fmc_insecure_skip_verify": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Skip certificate checks of the source FMC",
+						},
+					},
+				},
+				Description: "The FMC to read the objects from",
+			},
+			"network_objects": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the network objects to sync",
+			},
+			"host_objects": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the host objects to sync",
+			},
+			"range_objects": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the range objects to sync",
+			},
+			"fqdn_objects": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the fqdn objects to sync",
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the synced objects from the target FMC on destroy",
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the object",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the object",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the object",
+						},
+						"source_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the object on the source FMC",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the object on the target FMC",
+						},
+					},
+				},
+				Description: "The synced objects",
+			},
+		},
+	}
+}
+
+func syncFmcObjects(ctx context.Context, d *schema.ResourceData, target *Client) ([]interface{}, error) {
+	sourceConfig := d.Get("source").([]interface{})[0].(map[string]interface{})
+	source := NewClient(sourceConfig["fmc_username"].(string), sourceConfig["fmc_password"].(string), sourceConfig["fmc_host"].(string), sourceConfig["fmc_insecure_skip_verify"].(bool))
+	if err := source.Login(ctx); err != nil {
+		return nil, fmt.Errorf("logging in to source FMC %s: %s", sourceConfig["fmc_host"].(string), err.Error())
+	}
+
+	objects := []interface{}{}
+	for _, syncer := range object_syncers {
+		for _, name := range d.Get(syncer.key).([]interface{}) {
+			sourceID, id, value, err := syncer.sync(ctx, source, target, name.(string))
+			if err != nil {
+				return objects, fmt.Errorf("syncing %s %s: %s", syncer.objectType, name.(string), err.Error())
+			}
+			objects = append(objects, map[string]interface{}{
+				"name":      name.(string),
+				"type":      syncer.objectType,
+				"value":     value,
+				"source_id": sourceID,
+				"id":        id,
+			})
+		}
+	}
+	return objects, nil
+}
+
+func resourceFmcObjectSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	objects, err := syncFmcObjects(ctx, d, c)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to sync objects",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	sourceConfig := d.Get("source").([]interface{})[0].(map[string]interface{})
+	d.SetId(fmt.Sprintf("%s+%s", sourceConfig["fmc_host"].(string), c.host))
+	if err := d.Set("objects", objects); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	return resourceFmcObjectSyncRead(ctx, d, m)
+}
+
+func resourceFmcObjectSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	for _, obj := range d.Get("objects").([]interface{}) {
+		object := obj.(map[string]interface{})
+		for _, syncer := range object_syncers {
+			if syncer.objectType != object["type"].(string) {
+				continue
+			}
+			if err := syncer.get(ctx, c, object["id"].(string)); err != nil {
+				// Synced object was deleted on the target, so sync again
+				if strings.Contains(err.Error(), "404") {
+					d.SetId("")
+					return diags
+				}
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "unable to read synced object",
+					Detail:   err.Error(),
+				})
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcObjectSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("source", "network_objects", "host_objects", "range_objects", "fqdn_objects") {
+		objects, err := syncFmcObjects(ctx, d, c)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to sync objects",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		if err := d.Set("objects", objects); err != nil {
+			return returnWithDiag(diags, err)
+		}
+	}
+	return resourceFmcObjectSyncRead(ctx, d, m)
+}
+
+func resourceFmcObjectSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if d.Get("delete_on_destroy").(bool) {
+		for _, obj := range d.Get("objects").([]interface{}) {
+			object := obj.(map[string]interface{})
+			for _, syncer := range object_syncers {
+				if syncer.objectType != object["type"].(string) {
+					continue
+				}
+				err := syncer.delete(ctx, c, object["id"].(string))
+				if isNotFound(err) {
+					diags = append(diags, alreadyDeleted(fmt.Sprintf("synced %s %s", syncer.objectType, object["name"].(string)), err))
+				} else if err != nil {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "unable to delete synced object",
+						Detail:   err.Error(),
+					})
+					return diags
+				}
+			}
+		}
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
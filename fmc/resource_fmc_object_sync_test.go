@@ -0,0 +1,79 @@
+package fmc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcObjectSyncBasic(t *testing.T) {
+	// The FMC under test is both the source and the target, so the objects are synced onto themselves
+	name := "test_object_sync"
+	value := "10.10.10.0/24"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcObjectSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcObjectSyncConfigBasic(name, value),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcObjectSyncExists("fmc_object_sync.test", map[string]string{
+						"objects.#":       "1",
+						"objects.0.name":  name,
+						"objects.0.value": value,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcObjectSyncDestroy(s *terraform.State) error {
+	// Synced objects are not deleted unless delete_on_destroy is set, the network object resource cleans up
+	return nil
+}
+
+func testAccCheckFmcObjectSyncConfigBasic(name, value string) string {
+	return fmt.Sprintf(`
+    resource "fmc_network_objects" "test" {
+        name  = "%s"
+        value = "%s"
+    }
+    resource "fmc_object_sync" "test" {
+        source {
+            fmc_host                 = "%s"
+            fmc_username             = "%s"
+            fmc_password             = "%s"
+            fmc_insecure_skip_verify = true
+        }
+        network_objects = [fmc_network_objects.test.name]
+    }
+    `, name, value, os.Getenv("FMC_HOST"), os.Getenv("FMC_USERNAME"), os.Getenv("FMC_PASSWORD"))
+}
+
+func testAccCheckFmcObjectSyncExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -19,15 +19,25 @@ func resourceFmcPolicyDevicesAssignments() *schema.Resource {
 			"```hcl\n" +
 			"resource \"fmc_policy_devices_assignments\" \"policy_assignment\" {\n" +
 			"    policy {\n" +
-			"        id = fmc_access_policies.access_policy.id\n" +
-			"        type = fmc_access_policies.access_policy.type\n" +
+			"        id = fmc_access_policy.access_policy.id\n" +
+			"        type = fmc_access_policy.access_policy.type\n" +
 			"    }\n" +
 			"    target_devices {\n" +
 			"        id = data.fmc_devices.device.id\n" +
 			"        type = data.fmc_devices.device.type\n" +
 			"    }\n" +
 			"}\n" +
+			"\n" +
+			"resource \"fmc_policy_devices_assignments\" \"branches\" {\n" +
+			"    policy {\n" +
+			"        id = fmc_access_policy.branch_policy.id\n" +
+			"        type = fmc_access_policy.branch_policy.type\n" +
+			"    }\n" +
+			"    target_device_groups = [fmc_device_groups.branches.id]\n" +
+			"}\n" +
 			"```\n" +
+			"**Note** The policy is assigned to the members of the `target_device_groups`, the devices added to a group later are assigned on the next apply.\n" +
+			"\n" +
 			"**Note** You cannot delete a policy assignment, only reassign the devices to another policy. So, the delete operation on terraform does nothing, but the assignment is not deleted until you have manually moved the devices to another policy.",
 		CreateContext: resourceFmcPolicyDevicesAssignmentsCreate,
 		ReadContext:   resourceFmcPolicyDevicesAssignmentsRead,
@@ -65,9 +75,10 @@ func resourceFmcPolicyDevicesAssignments() *schema.Resource {
 				Description: "Policy (ACP/NAT) for this resource",
 			},
 			"target_devices": {
-				Type:     schema.TypeList,
-				MinItems: 1,
-				Required: true,
+				Type:         schema.TypeList,
+				MinItems:     1,
+				Optional:     true,
+				AtLeastOneOf: []string{"target_devices", "target_device_groups"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -84,6 +95,14 @@ func resourceFmcPolicyDevicesAssignments() *schema.Resource {
 				},
 				Description: "Target devices for this resource",
 			},
+			"target_device_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the device groups whose members are targets for this resource",
+			},
 			"type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -109,21 +128,19 @@ func resourceFmcPolicyDevicesAssignmentsCreate(ctx context.Context, d *schema.Re
 		}
 	}
 
-	var devices []PolicyDevicesAssignmentSubConfig
-
-	if inputObjs, ok := d.GetOk("target_devices"); ok {
-		for _, obj := range inputObjs.([]interface{}) {
-			obji := obj.(map[string]interface{})
-			devices = append(devices, PolicyDevicesAssignmentSubConfig{
-				ID:   obji["id"].(string),
-				Type: obji["type"].(string),
-			})
-		}
+	devices, err := expandPolicyAssignmentTargets(ctx, c, d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get target device groups",
+			Detail:   err.Error(),
+		})
+		return diags
 	}
 
 	res, err := c.CreateFmcPolicyDevicesAssignment(ctx, &PolicyDevicesAssignment{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Policy:      policy,
 		Targets:     devices,
 		Type:        policy_devices_assignments_type,
@@ -198,13 +215,24 @@ func resourceFmcPolicyDevicesAssignmentsRead(ctx context.Context, d *schema.Reso
 		return diags
 	}
 
-	devices := make([]interface{}, 0, len(item.Targets))
-	for _, obj := range item.Targets {
-		device := make(map[string]interface{})
-		device["id"] = obj.ID
-		device["type"] = obj.Type
-		devices = append(devices, device)
+	groups := map[string][]DeviceGroupMember{}
+	for _, groupID := range d.Get("target_device_groups").(*schema.Set).List() {
+		group, err := c.GetFmcDeviceGroup(ctx, groupID.(string))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read policy devices assignment",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+		groups[group.ID] = group.Members
 	}
+	configuredDevices := map[string]bool{}
+	for _, obj := range d.Get("target_devices").([]interface{}) {
+		configuredDevices[obj.(map[string]interface{})["id"].(string)] = true
+	}
+	devices, targetGroups := flattenPolicyAssignmentTargets(item.Targets, configuredDevices, groups)
 
 	if err := d.Set("target_devices", devices); err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -214,14 +242,88 @@ func resourceFmcPolicyDevicesAssignmentsRead(ctx context.Context, d *schema.Reso
 		})
 		return diags
 	}
+	if err := d.Set("target_device_groups", targetGroups); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read policy devices assignment",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
 	return diags
 }
 
+// expandPolicyAssignmentTargets returns the target devices and the members of the target device groups
+func expandPolicyAssignmentTargets(ctx context.Context, c *Client, d *schema.ResourceData) ([]PolicyDevicesAssignmentSubConfig, error) {
+	var devices []PolicyDevicesAssignmentSubConfig
+	assigned := map[string]bool{}
+	for _, obj := range d.Get("target_devices").([]interface{}) {
+		obji := obj.(map[string]interface{})
+		devices = append(devices, PolicyDevicesAssignmentSubConfig{
+			ID:   obji["id"].(string),
+			Type: obji["type"].(string),
+		})
+		assigned[obji["id"].(string)] = true
+	}
+
+	groupIDs := []string{}
+	for _, groupID := range d.Get("target_device_groups").(*schema.Set).List() {
+		groupIDs = append(groupIDs, groupID.(string))
+	}
+	members, err := c.GetFmcDeviceGroupsMembers(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if !assigned[member.ID] {
+			devices = append(devices, PolicyDevicesAssignmentSubConfig{
+				ID:   member.ID,
+				Type: member.Type,
+			})
+		}
+	}
+	return devices, nil
+}
+
+// flattenPolicyAssignmentTargets splits the targets of the assignment into the target devices, i.e. the configured
+// devices and the devices which are not members of the groups, and the groups whose members are all targets.
+// A group with members which are not targets, e.g. added after the last apply, is left out so that it is assigned again.
+func flattenPolicyAssignmentTargets(targets []PolicyDevicesAssignmentSubConfig, configuredDevices map[string]bool, groups map[string][]DeviceGroupMember) ([]interface{}, []interface{}) {
+	isTarget := map[string]bool{}
+	for _, target := range targets {
+		isTarget[target.ID] = true
+	}
+	isMember := map[string]bool{}
+	targetGroups := make([]interface{}, 0, len(groups))
+	for groupID, members := range groups {
+		complete := true
+		for _, member := range members {
+			isMember[member.ID] = true
+			complete = complete && isTarget[member.ID]
+		}
+		if complete {
+			targetGroups = append(targetGroups, groupID)
+		}
+	}
+
+	devices := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		if isMember[target.ID] && !configuredDevices[target.ID] {
+			continue
+		}
+		devices = append(devices, map[string]interface{}{
+			"id":   target.ID,
+			"type": target.Type,
+		})
+	}
+	return devices, targetGroups
+}
+
 func resourceFmcPolicyDevicesAssignmentsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 	var diags diag.Diagnostics
 	id := d.Id()
-	if d.HasChanges("name", "description", "policy", "target_devices") {
+	if d.HasChanges("name", "description", "policy", "target_devices", "target_device_groups") {
 		var policy PolicyDevicesAssignmentSubConfig
 
 		if inputObjs, ok := d.GetOk("policy"); ok {
@@ -232,21 +334,19 @@ func resourceFmcPolicyDevicesAssignmentsUpdate(ctx context.Context, d *schema.Re
 			}
 		}
 
-		var devices []PolicyDevicesAssignmentSubConfig
-
-		if inputObjs, ok := d.GetOk("target_devices"); ok {
-			for _, obj := range inputObjs.([]interface{}) {
-				obji := obj.(map[string]interface{})
-				devices = append(devices, PolicyDevicesAssignmentSubConfig{
-					ID:   obji["id"].(string),
-					Type: obji["type"].(string),
-				})
-			}
+		devices, err := expandPolicyAssignmentTargets(ctx, c, d)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to get target device groups",
+				Detail:   err.Error(),
+			})
+			return diags
 		}
 
-		_, err := c.UpdateFmcPolicyDevicesAssignment(ctx, id, &PolicyDevicesAssignment{
+		_, err = c.UpdateFmcPolicyDevicesAssignment(ctx, id, &PolicyDevicesAssignment{
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Policy:      policy,
 			Targets:     devices,
 			Type:        policy_devices_assignments_type,
@@ -3,6 +3,7 @@ package fmc
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -103,3 +104,28 @@ func testAccCheckFmcPolicyDeviceAssignmentsExists(n string) resource.TestCheckFu
 		return nil
 	}
 }
+
+func TestFlattenPolicyAssignmentTargets(t *testing.T) {
+	targets := []PolicyDevicesAssignmentSubConfig{
+		{ID: "ftd1", Type: "Device"},
+		{ID: "ftd2", Type: "Device"},
+		{ID: "ftd3", Type: "Device"},
+	}
+	groups := map[string][]DeviceGroupMember{
+		"branches": {{ID: "ftd2", Type: "Device"}, {ID: "ftd3", Type: "Device"}},
+		// ftd4 was added to the group after the last apply
+		"lab": {{ID: "ftd1", Type: "Device"}, {ID: "ftd4", Type: "Device"}},
+	}
+	devices, targetGroups := flattenPolicyAssignmentTargets(targets, map[string]bool{"ftd3": true}, groups)
+
+	deviceIDs := []string{}
+	for _, device := range devices {
+		deviceIDs = append(deviceIDs, device.(map[string]interface{})["id"].(string))
+	}
+	if expected := []string{"ftd3"}; !reflect.DeepEqual(deviceIDs, expected) {
+		t.Errorf("expected target devices %v, got %v", expected, deviceIDs)
+	}
+	if expected := []interface{}{"branches"}; !reflect.DeepEqual(targetGroups, expected) {
+		t.Errorf("expected target device groups %v, got %v", expected, targetGroups)
+	}
+}
@@ -2,6 +2,7 @@ package fmc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,13 +10,16 @@ import (
 
 var port_group_type string = "PortObjectGroup"
 
+// The types of the objects in port groups
+var port_group_member_types = []string{"ProtocolPortObject", "ICMPV4Object", "ICMPV6Object"}
+
 func resourceFmcPortGroupObjects() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource for Port Group Objects in FMC\n" +
 			"\n" +
+			"## Example\n" +
 			"An example is shown below: \n" +
 			"```hcl\n" +
-			"## Example\n" +
 			"resource \"fmc_port_group_objects\" \"port-group\" {\n" +
 			"    name = \"TCP-ICMP\"\n" +
 			"    description = \"Combo ports\"\n" +
@@ -28,16 +32,21 @@ func resourceFmcPortGroupObjects() *schema.Resource {
 			"        type = fmc_icmpv4_objects.wrong-proto.type\n" +
 			"    }\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** The objects are a set of port and ICMP objects, adding or removing objects updates the group in place.",
 		CreateContext: resourceFmcPortGroupObjectsCreate,
 		ReadContext:   resourceFmcPortGroupObjectsRead,
 		UpdateContext: resourceFmcPortGroupObjectsUpdate,
 		DeleteContext: resourceFmcPortGroupObjectsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -51,13 +60,7 @@ func resourceFmcPortGroupObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -65,7 +68,7 @@ func resourceFmcPortGroupObjects() *schema.Resource {
 				Description: "The type of this resource",
 			},
 			"objects": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -75,13 +78,23 @@ func resourceFmcPortGroupObjects() *schema.Resource {
 							Description: "The ID of this resource",
 						},
 						"type": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The type of this resource",
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := val.(string)
+								for _, allowed := range port_group_member_types {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, port_group_member_types, v))
+								return
+							},
+							Description: `The type of this resource, "ProtocolPortObject", "ICMPV4Object" or "ICMPV6Object"`,
 						},
 					},
 				},
-				Description: "The list of port groups to add",
+				Description: "The set of port and ICMP objects to add",
 			},
 		},
 	}
@@ -93,23 +106,11 @@ func resourceFmcPortGroupObjectsCreate(ctx context.Context, d *schema.ResourceDa
 	// var diags diag.Diagnostics
 	var diags diag.Diagnostics
 
-	var objs []PortGroupObjectObjects
-
-	if inputObjs, ok := d.GetOk("objects"); ok {
-		for _, obj := range inputObjs.([]interface{}) {
-			obji := obj.(map[string]interface{})
-			objs = append(objs, PortGroupObjectObjects{
-				ID:   obji["id"].(string),
-				Type: obji["type"].(string),
-			})
-		}
-	}
-
 	res, err := c.CreateFmcPortGroupObject(ctx, &PortGroupObject{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Type:        port_group_type,
-		Objects:     objs,
+		Objects:     portGroupMembers(d),
 	})
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -191,24 +192,12 @@ func resourceFmcPortGroupObjectsUpdate(ctx context.Context, d *schema.ResourceDa
 	var diags diag.Diagnostics
 	id := d.Id()
 	if d.HasChanges("name", "description", "objects") {
-		var objs []PortGroupObjectObjects
-
-		if inputObjs, ok := d.GetOk("objects"); ok {
-			for _, obj := range inputObjs.([]interface{}) {
-				obji := obj.(map[string]interface{})
-				objs = append(objs, PortGroupObjectObjects{
-					ID:   obji["id"].(string),
-					Type: obji["type"].(string),
-				})
-			}
-		}
-
 		_, err := c.UpdateFmcPortGroupObject(ctx, id, &PortGroupObjectUpdateInput{
 			ID:          d.Id(),
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Type:        port_group_type,
-			Objects:     objs,
+			Objects:     portGroupMembers(d),
 		})
 
 		if err != nil {
@@ -223,6 +212,18 @@ func resourceFmcPortGroupObjectsUpdate(ctx context.Context, d *schema.ResourceDa
 	return resourceFmcPortGroupObjectsRead(ctx, d, m)
 }
 
+func portGroupMembers(d *schema.ResourceData) []PortGroupObjectObjects {
+	var objs []PortGroupObjectObjects
+	for _, obj := range d.Get("objects").(*schema.Set).List() {
+		obji := obj.(map[string]interface{})
+		objs = append(objs, PortGroupObjectObjects{
+			ID:   obji["id"].(string),
+			Type: obji["type"].(string),
+		})
+	}
+	return objs
+}
+
 func resourceFmcPortGroupObjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*Client)
 
@@ -232,7 +233,9 @@ func resourceFmcPortGroupObjectsDelete(ctx context.Context, d *schema.ResourceDa
 	id := d.Id()
 
 	err := c.DeleteFmcPortGroupObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("port group object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete port group object",
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -23,8 +24,21 @@ func TestAccFmcPortGroupObjectsBasic(t *testing.T) {
 				Config: testAccCheckFmcPortGroupObjectsConfigBasic(name, description),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFmcPortGroupObjectsExists("fmc_port_group_objects.test"),
+					resource.TestCheckResourceAttr("fmc_port_group_objects.test", "objects.#", "2"),
 				),
 			},
+			{
+				Config: testAccCheckFmcPortGroupObjectsConfigPortsOnly(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcPortGroupObjectsExists("fmc_port_group_objects.test"),
+					resource.TestCheckResourceAttr("fmc_port_group_objects.test", "objects.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "fmc_port_group_objects.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -77,6 +91,23 @@ func testAccCheckFmcPortGroupObjectsConfigBasic(name, description string) string
     `, name, description)
 }
 
+func testAccCheckFmcPortGroupObjectsConfigPortsOnly(name string) string {
+	return fmt.Sprintf(`
+	resource "fmc_port_objects" "test1" {
+		name        = "test_port_1"
+		port        = "80"
+		protocol 	= "TCP"
+	}
+    resource "fmc_port_group_objects" "test" {
+		name = "%s"
+		objects {
+			id = fmc_port_objects.test1.id
+			type = fmc_port_objects.test1.type
+		}
+	}
+    `, name)
+}
+
 func testAccCheckFmcPortGroupObjectsExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -92,3 +123,18 @@ func testAccCheckFmcPortGroupObjectsExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestPortGroupObjectMemberValidation(t *testing.T) {
+	validate := resourceFmcPortGroupObjects().Schema["objects"].Elem.(*schema.Resource).Schema["type"].ValidateFunc
+	for memberType, valid := range map[string]bool{
+		"ProtocolPortObject": true,
+		"ICMPV4Object":       true,
+		"ICMPV6Object":       true,
+		"PortObjectGroup":    false,
+		"Network":            false,
+	} {
+		if _, errs := validate(memberType, "type"); (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got %v", memberType, valid, errs)
+		}
+	}
+}
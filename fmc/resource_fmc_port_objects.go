@@ -2,6 +2,10 @@ package fmc
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,6 +13,29 @@ import (
 
 var port_type string = "ProtocolPortObject"
 
+// A single port, e.g. "443", or a range of ports, e.g. "1024-65535"
+var port_value_regexp = regexp.MustCompile(`^([0-9]+)(-([0-9]+))?$`)
+
+func validatePortValue(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	match := port_value_regexp.FindStringSubmatch(v)
+	if match == nil {
+		errs = append(errs, fmt.Errorf("%q must be a port or a range of ports, e.g. \"443\" or \"1024-65535\", got: %q", key, v))
+		return
+	}
+	start, _ := strconv.Atoi(match[1])
+	end := start
+	if match[3] != "" {
+		end, _ = strconv.Atoi(match[3])
+	}
+	if start < 1 || end > 65535 {
+		errs = append(errs, fmt.Errorf("%q must be in 1-65535, got: %q", key, v))
+	} else if start > end {
+		errs = append(errs, fmt.Errorf("%q must not start after it ends, got: %q", key, v))
+	}
+	return
+}
+
 func resourceFmcPortObjects() *schema.Resource {
 	return &schema.Resource{
 		Description: "Resource for Port Objects in FMC\n" +
@@ -21,26 +48,50 @@ func resourceFmcPortObjects() *schema.Resource {
 			"    port = \"80\"\n" +
 			"    protocol = \"TCP\"\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note:** `port` can be a single port, e.g. \"443\", or a range of ports, e.g. \"1024-65535\". " +
+			"Existing port objects can be imported by ID or by name, e.g. `terraform import fmc_port_objects.http name=HTTP`",
 		CreateContext: resourceFmcPortObjectsCreate,
 		ReadContext:   resourceFmcPortObjectsRead,
 		UpdateContext: resourceFmcPortObjectsUpdate,
 		DeleteContext: resourceFmcPortObjectsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFmcPortObjectsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"port": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Port for this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePortValue,
+				Description:  "Port or range of ports for this resource, e.g. \"443\" or \"1024-65535\"",
 			},
 			"protocol": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Protocol for this resource",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"TCP", "UDP"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `Protocol for this resource, "TCP" or "UDP"`,
 			},
 			"overridable": {
 				Type:        schema.TypeBool,
@@ -65,7 +116,7 @@ func resourceFmcPortObjectsCreate(ctx context.Context, d *schema.ResourceData, m
 	res, err := c.CreateFmcPortObject(ctx, &PortObject{
 		Name:        d.Get("name").(string),
 		Port:        d.Get("port").(string),
-		Protocol:    d.Get("protocol").(string),
+		Protocol:    strings.ToUpper(d.Get("protocol").(string)),
 		Overridable: d.Get("overridable").(bool),
 		Type:        port_type,
 	})
@@ -124,6 +175,15 @@ func resourceFmcPortObjectsRead(ctx context.Context, d *schema.ResourceData, m i
 		return diags
 	}
 
+	if err := d.Set("overridable", item.Overridable); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read port object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
 	if err := d.Set("type", item.Type); err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -144,7 +204,7 @@ func resourceFmcPortObjectsUpdate(ctx context.Context, d *schema.ResourceData, m
 		_, err := c.UpdateFmcPortObject(ctx, id, &PortObjectUpdateInput{
 			Name:        d.Get("name").(string),
 			Port:        d.Get("port").(string),
-			Protocol:    d.Get("protocol").(string),
+			Protocol:    strings.ToUpper(d.Get("protocol").(string)),
 			Overridable: d.Get("overridable").(bool),
 			Type:        port_type,
 			ID:          id,
@@ -170,7 +230,9 @@ func resourceFmcPortObjectsDelete(ctx context.Context, d *schema.ResourceData, m
 	id := d.Id()
 
 	err := c.DeleteFmcPortObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("port object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete port object",
@@ -185,3 +247,22 @@ func resourceFmcPortObjectsDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+func resourceFmcPortObjectsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*Client)
+
+	if !strings.HasPrefix(d.Id(), "name=") {
+		return []*schema.ResourceData{d}, nil
+	}
+	name := strings.TrimPrefix(d.Id(), "name=")
+	item, err := c.GetFmcPortObjectByNameOrPort(ctx, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import port object %s: %s", name, err.Error())
+	}
+	// The lookup also matches on the port, only accept an exact name match
+	if item.Name != name {
+		return nil, fmt.Errorf("unable to import port object %s: no port object found with this name", name)
+	}
+	d.SetId(item.ID)
+	return []*schema.ResourceData{d}, nil
+}
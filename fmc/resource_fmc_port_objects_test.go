@@ -26,6 +26,17 @@ func TestAccFmcPortObjectBasic(t *testing.T) {
 					testAccCheckFmcPortObjectExists("fmc_port_objects.test"),
 				),
 			},
+			{
+				ResourceName:      "fmc_port_objects.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "fmc_port_objects.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("name=%s", name),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -76,3 +87,35 @@ func testAccCheckFmcPortObjectExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestPortObjectValidation(t *testing.T) {
+	schema := resourceFmcPortObjects().Schema
+	for port, valid := range map[string]bool{
+		"443":        true,
+		"1":          true,
+		"65535":      true,
+		"1024-65535": true,
+		"80-80":      true,
+		"0":          false,
+		"65536":      false,
+		"8080-80":    false,
+		"1-65536":    false,
+		"80,443":     false,
+		"http":       false,
+		"":           false,
+	} {
+		if _, errs := schema["port"].ValidateFunc(port, "port"); (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got %v", port, valid, errs)
+		}
+	}
+	for protocol, valid := range map[string]bool{
+		"TCP":  true,
+		"udp":  true,
+		"ICMP": false,
+		"SCTP": false,
+	} {
+		if _, errs := schema["protocol"].ValidateFunc(protocol, "protocol"); (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got %v", protocol, valid, errs)
+		}
+	}
+}
@@ -29,9 +29,10 @@ func resourceFmcPrefilterPolicy() *schema.Resource {
 		DeleteContext: resourceFmcPrefilterPolicyDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_policy_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -45,13 +46,7 @@ func resourceFmcPrefilterPolicy() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"default_action": {
 				Type:     schema.TypeList,
@@ -117,7 +112,7 @@ func resourceFmcPrefilterPolicyCreate(ctx context.Context, d *schema.ResourceDat
 
 	res, err := c.CreateFmcPrefilterPolicy(ctx, &PrefilterPolicyInput{
 		Name:          d.Get("name").(string),
-		Description:   d.Get("description").(string),
+		Description:   c.withDescriptionMarker(d),
 		DefaultAction: defaultAction,
 	})
 	if err != nil {
@@ -203,7 +198,7 @@ func resourceFmcPrefilterPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 		res, err := c.UpdateFmcPrefilterPolicy(ctx, &PrefilterPolicy{
 			ID:            d.Id(),
 			Name:          d.Get("name").(string),
-			Description:   d.Get("description").(string),
+			Description:   c.withDescriptionMarker(d),
 			DefaultAction: defaultAction,
 		})
 
@@ -229,7 +224,9 @@ func resourceFmcPrefilterPolicyDelete(ctx context.Context, d *schema.ResourceDat
 	id := d.Id()
 
 	err := c.DeleteFmcPrefilterPolicy(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("prefilter policy", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete prefilter policy",
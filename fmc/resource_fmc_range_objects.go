@@ -2,6 +2,8 @@ package fmc
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,21 +23,30 @@ func resourceFmcRangeObjects() *schema.Resource {
 			"    value       = \"10.10.10.10-10.10.10.16\"\n" +
 			"    description = \"K8s Prod Cluster\"\n" +
 			"}\n" +
-			"```",
+			"```\n" +
+			"**Note** The value is checked during plan, the start address must not be after the end address and both must be IPv4 or IPv6 addresses.\n" +
+			"\n" +
+			"**Note** Existing objects can be imported either by ID or by name, e.g. `terraform import fmc_range_objects.servers name=k8s-cluster`.",
 		CreateContext: resourceFmcRangeObjectsCreate,
 		ReadContext:   resourceFmcRangeObjectsRead,
 		UpdateContext: resourceFmcRangeObjectsUpdate,
 		DeleteContext: resourceFmcRangeObjectsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFmcRangeObjectsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The value of this resource",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIPValue(ip_value_range),
+				DiffSuppressFunc: suppressEquivalentIPValues,
+				Description:      "The IPv4 or IPv6 range of this resource, e.g. \"10.0.0.1-10.0.0.9\" or \"2001:db8::1-2001:db8::9\"",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -49,13 +60,7 @@ func resourceFmcRangeObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 		},
 	}
@@ -69,7 +74,7 @@ func resourceFmcRangeObjectsCreate(ctx context.Context, d *schema.ResourceData,
 
 	res, err := c.CreateFmcRangeObject(ctx, &RangeObject{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Value:       d.Get("value").(string),
 		Type:        range_type,
 	})
@@ -137,7 +142,7 @@ func resourceFmcRangeObjectsUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChanges("name", "description", "value") {
 		_, err := c.UpdateFmcRangeObject(ctx, id, &RangeObjectUpdateInput{
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Value:       d.Get("value").(string),
 			Type:        range_type,
 			ID:          id,
@@ -163,7 +168,9 @@ func resourceFmcRangeObjectsDelete(ctx context.Context, d *schema.ResourceData,
 	id := d.Id()
 
 	err := c.DeleteFmcRangeObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("network object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete network object",
@@ -178,3 +185,24 @@ func resourceFmcRangeObjectsDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// resourceFmcRangeObjectsImport accepts either the ID of the object or "name=<object name>", like the import of
+// the network objects
+func resourceFmcRangeObjectsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*Client)
+
+	if !strings.HasPrefix(d.Id(), "name=") {
+		return []*schema.ResourceData{d}, nil
+	}
+	name := strings.TrimPrefix(d.Id(), "name=")
+	item, err := c.GetFmcRangeObjectByNameOrValue(ctx, name, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import range object %s: %s", name, err.Error())
+	}
+	// The lookup also matches on the value, only accept an exact name match
+	if item.Name != name {
+		return nil, fmt.Errorf("unable to import range object %s: no range object found with this name", name)
+	}
+	d.SetId(item.ID)
+	return []*schema.ResourceData{d}, nil
+}
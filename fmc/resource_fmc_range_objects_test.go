@@ -26,6 +26,17 @@ func TestAccFmcRangeObjectBasic(t *testing.T) {
 					testAccCheckFmcRangeObjectExists("fmc_range_objects.test"),
 				),
 			},
+			{
+				ResourceName:      "fmc_range_objects.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "fmc_range_objects.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("name=%s", name),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -29,9 +29,10 @@ func resourceFmcSecurityZone() *schema.Resource {
 		DeleteContext: resourceFmcSecurityZoneDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of security zone",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of security zone",
 			},
 			"interface_mode": {
 				Type:        schema.TypeString,
@@ -145,7 +146,9 @@ func resourceFmcSecurityZoneDelete(ctx context.Context, d *schema.ResourceData,
 	id := d.Id()
 
 	err := c.DeleteFmcSecurityZone(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("security zone", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete security zone",
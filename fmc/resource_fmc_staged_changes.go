@@ -0,0 +1,208 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFmcStagedChanges() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for deploying all the changes of an apply to FTD devices at once in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_staged_changes\" \"deploy\" {\n" +
+			"    devices = [data.fmc_devices.ftd1.id, data.fmc_devices.ftd2.id]\n" +
+			"    allow_traffic_interruption = false\n" +
+			"    depends_on = [module.policies, module.objects]\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** This resource runs on every apply after the resources and modules in its `depends_on`, " +
+			"it sends a single deployment for the `devices` and the members of the `device_groups` with changes pending in FMC and does nothing if there are none. " +
+			"Unlike `fmc_ftd_deploy` there is no need to list triggers, depend on the modules which change the policies instead.",
+		CreateContext: resourceFmcStagedChangesCreate,
+		ReadContext:   resourceFmcStagedChangesRead,
+		UpdateContext: resourceFmcStagedChangesCreate,
+		DeleteContext: resourceFmcStagedChangesDelete,
+		Schema: map[string]*schema.Schema{
+			"devices": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"devices", "device_groups"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the FTD devices to deploy to",
+			},
+			"device_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the device groups whose members to deploy to",
+			},
+			"force_deploy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ignore_warning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"allow_traffic_interruption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Deploy even if the deployment interrupts the traffic, e.g. by restarting snort",
+			},
+			"deployed_devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the devices which had changes pending and were deployed to",
+			},
+		},
+	}
+}
+
+// stagedDeployment returns the deployable devices among the device IDs, sorted by ID, and the version to deploy them at,
+// which is the latest version of their changes
+func stagedDeployment(deployableDevices []DeployableDeviceResponse, deviceIDs []string) ([]DeployableDeviceResponse, string) {
+	wanted := map[string]bool{}
+	for _, id := range deviceIDs {
+		wanted[id] = true
+	}
+	devices := []DeployableDeviceResponse{}
+	version := ""
+	for _, device := range deployableDevices {
+		if !wanted[device.Device.ID] {
+			continue
+		}
+		devices = append(devices, device)
+		// The versions are timestamps, so a longer one is later
+		if len(device.Version) > len(version) || (len(device.Version) == len(version) && device.Version > version) {
+			version = device.Version
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Device.ID < devices[j].Device.ID
+	})
+	return devices, version
+}
+
+func expandStagedChangesDevices(d *schema.ResourceData) []string {
+	devices := []string{}
+	for _, device := range d.Get("devices").(*schema.Set).List() {
+		devices = append(devices, device.(string))
+	}
+	return devices
+}
+
+func resourceFmcStagedChangesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	deployableDevices, err := c.GetFmcDeployableDevices(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get deployable devices",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	deviceIDs := expandStagedChangesDevices(d)
+	groupIDs := []string{}
+	for _, groupID := range d.Get("device_groups").(*schema.Set).List() {
+		groupIDs = append(groupIDs, groupID.(string))
+	}
+	members, err := c.GetFmcDeviceGroupsMembers(ctx, groupIDs)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to get device groups",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	for _, member := range members {
+		deviceIDs = append(deviceIDs, member.ID)
+	}
+	devices, version := stagedDeployment(deployableDevices, deviceIDs)
+
+	deviceIDs = []string{}
+	for _, device := range devices {
+		if strings.EqualFold(device.Trafficinterruption, "yes") {
+			if !d.Get("allow_traffic_interruption").(bool) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Deployment interrupts the traffic!",
+					Detail:   fmt.Sprintf("Deploying to device Name: %s ID: %s restarts snort, set allow_traffic_interruption to deploy anyway", device.Name, device.Device.ID),
+				})
+				return diags
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Deployment interrupts the traffic!",
+				Detail:   fmt.Sprintf("Deploying to device Name: %s ID: %s restarts snort", device.Name, device.Device.ID),
+			})
+		}
+		deviceIDs = append(deviceIDs, device.Device.ID)
+	}
+
+	if len(deviceIDs) > 0 {
+		err = c.DeployToFTD(ctx, FtdDeploy{
+			Type:          deployment_type,
+			Version:       version,
+			Forcedeploy:   d.Get("force_deploy").(bool),
+			Ignorewarning: d.Get("ignore_warning").(bool),
+			Devicelist:    deviceIDs,
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error in deployment, there might be another deployment in progress!",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	d.SetId(fmt.Sprintf("Deployment of staged changes to %d devices", len(deviceIDs)))
+	if err := d.Set("deployed_devices", deviceIDs); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	return diags
+}
+
+func resourceFmcStagedChangesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Invalidate state, so the staged changes are deployed on every apply
+	d.SetId("")
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceFmcStagedChangesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Deployments cannot be undone, so only remove this resource from the state
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
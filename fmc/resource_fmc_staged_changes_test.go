@@ -0,0 +1,82 @@
+package fmc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcStagedChangesBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcStagedChangesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcStagedChangesConfigBasic(device),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcStagedChangesExists("fmc_staged_changes.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestStagedDeployment(t *testing.T) {
+	deployableDevices := []DeployableDeviceResponse{}
+	for _, device := range []struct{ id, version string }{{"b", "1659000000000"}, {"a", "1660000000000"}, {"c", "999"}} {
+		deployableDevice := DeployableDeviceResponse{Version: device.version}
+		deployableDevice.Device.ID = device.id
+		deployableDevices = append(deployableDevices, deployableDevice)
+	}
+
+	devices, version := stagedDeployment(deployableDevices, []string{"a", "b", "d"})
+	if len(devices) != 2 || devices[0].Device.ID != "a" || devices[1].Device.ID != "b" {
+		t.Errorf("expected devices a and b, got %v", devices)
+	}
+	if version != "1660000000000" {
+		t.Errorf("expected version 1660000000000, got %s", version)
+	}
+
+	devices, version = stagedDeployment(deployableDevices, []string{"d"})
+	if len(devices) != 0 || version != "" {
+		t.Errorf("expected no devices, got %v %s", devices, version)
+	}
+}
+
+func testAccCheckFmcStagedChangesDestroy(s *terraform.State) error {
+	// Deployments cannot be undone, there is nothing to check
+	return nil
+}
+
+func testAccCheckFmcStagedChangesConfigBasic(device string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "test" {
+        name = "%s"
+    }
+    resource "fmc_staged_changes" "test" {
+        devices = [data.fmc_devices.test.id]
+    }
+    `, device)
+}
+
+func testAccCheckFmcStagedChangesExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		return nil
+	}
+}
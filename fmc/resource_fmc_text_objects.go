@@ -0,0 +1,209 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var text_object_type string = "TextObject"
+
+func resourceFmcTextObjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for Text Objects (FlexConfig variables) in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_text_objects\" \"ecmp_zone\" {\n" +
+			"    name = \"ecmp_zone_name\"\n" +
+			"    description = \"ECMP zone for the outside interfaces\"\n" +
+			"    variable_type = \"MULTIPLE\"\n" +
+			"    values = [\"outside-ecmp\"]\n" +
+			"}\n" +
+			"```",
+		CreateContext: resourceFmcTextObjectsCreate,
+		ReadContext:   resourceFmcTextObjectsRead,
+		UpdateContext: resourceFmcTextObjectsUpdate,
+		DeleteContext: resourceFmcTextObjectsDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of this resource",
+				Default:     " ",
+				StateFunc: func(val interface{}) string {
+					state := val.(string)
+					if val == nil || state == "" || state == " " {
+						return " "
+					}
+					return state
+				},
+				DiffSuppressFunc: suppressDescriptionMarker,
+			},
+			"variable_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "MULTIPLE",
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := strings.ToUpper(val.(string))
+					allowedValues := []string{"MULTIPLE", "FREEFORM"}
+					for _, allowed := range allowedValues {
+						if v == allowed {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `The variable type of this resource, "MULTIPLE" or "FREEFORM"`,
+			},
+			"values": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The values of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandTextObjectValues(d *schema.ResourceData) []string {
+	values := []string{}
+	for _, value := range d.Get("values").([]interface{}) {
+		values = append(values, value.(string))
+	}
+	return values
+}
+
+func resourceFmcTextObjectsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	res, err := c.CreateFmcTextObject(ctx, &TextObject{
+		Name:         d.Get("name").(string),
+		Type:         text_object_type,
+		Description:  c.withDescriptionMarker(d),
+		Variabletype: strings.ToUpper(d.Get("variable_type").(string)),
+		Values:       expandTextObjectValues(d),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create text object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcTextObjectsRead(ctx, d, m)
+}
+
+func resourceFmcTextObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcTextObject(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read text object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":          item.Name,
+		"type":          item.Type,
+		"description":   item.Description,
+		"variable_type": item.Variabletype,
+		"values":        item.Values,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read text object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcTextObjectsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("name", "description", "variable_type", "values") {
+		_, err := c.UpdateFmcTextObject(ctx, d.Id(), &TextObject{
+			ID:           d.Id(),
+			Name:         d.Get("name").(string),
+			Type:         text_object_type,
+			Description:  c.withDescriptionMarker(d),
+			Variabletype: strings.ToUpper(d.Get("variable_type").(string)),
+			Values:       expandTextObjectValues(d),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update text object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcTextObjectsRead(ctx, d, m)
+}
+
+func resourceFmcTextObjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcTextObject(ctx, d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("text object", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete text object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,96 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcTextObjectBasic(t *testing.T) {
+	name := "test_text_object"
+	value := "outside-ecmp"
+	valueUpdated := "outside-ecmp-updated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcTextObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcTextObjectConfigBasic(name, value),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcTextObjectExists("fmc_text_objects.test", map[string]string{
+						"name":     name,
+						"values.0": value,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcTextObjectConfigBasic(name, valueUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcTextObjectExists("fmc_text_objects.test", map[string]string{
+						"name":     name,
+						"values.0": valueUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcTextObjectDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_text_objects" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcTextObject(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcTextObjectConfigBasic(name, value string) string {
+	return fmt.Sprintf(`
+    resource "fmc_text_objects" "test" {
+        name          = "%s"
+        variable_type = "MULTIPLE"
+        values        = ["%s"]
+    }
+    `, name, value)
+}
+
+func testAccCheckFmcTextObjectExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -29,9 +29,10 @@ func resourceFmcTimeRangeObject() *schema.Resource {
 		DeleteContext: resourceFmcTimeRangeObjectDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"effective_start_date": {
 				Type:        schema.TypeString,
@@ -55,13 +56,7 @@ func resourceFmcTimeRangeObject() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"recurrence": {
 				Type:     schema.TypeList,
@@ -185,7 +180,7 @@ func resourceFmcTimeRangeObjectCreate(ctx context.Context, d *schema.ResourceDat
 
 	res, err := c.CreateFmcTimeRangeObject(ctx, &TimeRangeObjectInput{
 		Name:               d.Get("name").(string),
-		Description:        d.Get("description").(string),
+		Description:        c.withDescriptionMarker(d),
 		EffectiveStartDate: d.Get("effective_start_date").(string),
 		EffectiveEndDate:   d.Get("effective_end_date").(string),
 		RecurrenceList:     recurrences,
@@ -332,7 +327,7 @@ func resourceFmcTimeRangeObjectUpdate(ctx context.Context, d *schema.ResourceDat
 
 		_, err := c.UpdateFmcTimeRangeObject(ctx, id, &TimeRangeObject{
 			Name:               d.Get("name").(string),
-			Description:        d.Get("description").(string),
+			Description:        c.withDescriptionMarker(d),
 			EffectiveStartDate: d.Get("effective_start_date").(string),
 			EffectiveEndDate:   d.Get("effective_end_date").(string),
 			ID:                 id,
@@ -359,7 +354,9 @@ func resourceFmcTimeRangeObjectDelete(ctx context.Context, d *schema.ResourceDat
 	id := d.Id()
 
 	err := c.DeleteFmcTimeRangeObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("time range object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete time range object",
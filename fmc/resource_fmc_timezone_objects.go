@@ -0,0 +1,171 @@
+package fmc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var timezone_object_type string = "TimeZoneObject"
+
+func resourceFmcTimezoneObjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for Timezone Objects in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_timezone_objects\" \"berlin\" {\n" +
+			"    name = \"Berlin\"\n" +
+			"    description = \"Timezone of the branch offices\"\n" +
+			"    timezone = \"Europe/Berlin\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Time ranges of access rules are evaluated in the timezone of the device, " +
+			"use `fmc_ftd_timezone` to assign the timezone object to the devices.",
+		CreateContext: resourceFmcTimezoneObjectsCreate,
+		ReadContext:   resourceFmcTimezoneObjectsRead,
+		UpdateContext: resourceFmcTimezoneObjectsUpdate,
+		DeleteContext: resourceFmcTimezoneObjectsDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of this resource",
+				Default:     " ",
+				StateFunc: func(val interface{}) string {
+					state := val.(string)
+					if val == nil || state == "" || state == " " {
+						return " "
+					}
+					return state
+				},
+				DiffSuppressFunc: suppressDescriptionMarker,
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The IANA timezone of this resource, e.g. "Europe/Berlin" or "America/New_York"`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func resourceFmcTimezoneObjectsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	res, err := c.CreateFmcTimezoneObject(ctx, &TimezoneObject{
+		Name:        d.Get("name").(string),
+		Type:        timezone_object_type,
+		Description: c.withDescriptionMarker(d),
+		Timezoneid:  d.Get("timezone").(string),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create timezone object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcTimezoneObjectsRead(ctx, d, m)
+}
+
+func resourceFmcTimezoneObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcTimezoneObject(ctx, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read timezone object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":        item.Name,
+		"type":        item.Type,
+		"description": item.Description,
+		"timezone":    item.Timezoneid,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read timezone object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcTimezoneObjectsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("name", "description", "timezone") {
+		_, err := c.UpdateFmcTimezoneObject(ctx, d.Id(), &TimezoneObject{
+			ID:          d.Id(),
+			Name:        d.Get("name").(string),
+			Type:        timezone_object_type,
+			Description: c.withDescriptionMarker(d),
+			Timezoneid:  d.Get("timezone").(string),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update timezone object",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcTimezoneObjectsRead(ctx, d, m)
+}
+
+func resourceFmcTimezoneObjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcTimezoneObject(ctx, d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("timezone object", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete timezone object",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,95 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcTimezoneObjectBasic(t *testing.T) {
+	name := "test_timezone_object"
+	timezone := "Europe/Berlin"
+	timezoneUpdated := "America/New_York"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcTimezoneObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcTimezoneObjectConfigBasic(name, timezone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcTimezoneObjectExists("fmc_timezone_objects.test", map[string]string{
+						"name":     name,
+						"timezone": timezone,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcTimezoneObjectConfigBasic(name, timezoneUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcTimezoneObjectExists("fmc_timezone_objects.test", map[string]string{
+						"name":     name,
+						"timezone": timezoneUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcTimezoneObjectDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_timezone_objects" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcTimezoneObject(ctx, id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcTimezoneObjectConfigBasic(name, timezone string) string {
+	return fmt.Sprintf(`
+    resource "fmc_timezone_objects" "test" {
+        name     = "%s"
+        timezone = "%s"
+    }
+    `, name, timezone)
+}
+
+func testAccCheckFmcTimezoneObjectExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,201 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The troubleshoot API was added in FMC 7.1
+var troubleshoot_files_minimum_version string = "7.1"
+
+func resourceFmcTroubleshootFiles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for generating troubleshoot files of FTD devices in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_troubleshoot_files\" \"ftd\" {\n" +
+			"    device = data.fmc_devices.ftd.id\n" +
+			"    output_path = \"${path.module}/troubleshoot/ftd.tar.gz\"\n" +
+			"    triggers = {\n" +
+			"        incident = var.incident_id\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** The troubleshoot API needs FMC 7.1+. The files are generated on create and whenever `triggers` change, " +
+			"and downloaded to `output_path` if set, they are generated again if the download is missing. " +
+			"Destroying this resource does not delete the files in FMC.",
+		CreateContext: resourceFmcTroubleshootFilesCreate,
+		ReadContext:   resourceFmcTroubleshootFilesRead,
+		DeleteContext: resourceFmcTroubleshootFilesDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return m.(*Client).requireFmcVersion(ctx, "fmc_troubleshoot_files", troubleshoot_files_minimum_version)
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD device",
+			},
+			"troubleshoot_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ALL",
+				StateFunc: func(val interface{}) string {
+					return strings.ToUpper(val.(string))
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				Description: `The data to collect in the troubleshoot files, "ALL" by default`,
+			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local path to download the troubleshoot files to",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Arbitrary values, changing them generates the troubleshoot files again",
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the task which generated the troubleshoot files",
+			},
+		},
+	}
+}
+
+func resourceFmcTroubleshootFilesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	if err := c.requireFmcVersion(ctx, "fmc_troubleshoot_files", troubleshoot_files_minimum_version); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to generate troubleshoot files",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	res, err := c.CreateFmcTroubleshootFiles(ctx, &TroubleshootFiles{
+		Troubleshootlevel: strings.ToUpper(d.Get("troubleshoot_level").(string)),
+		Devicelist: []TroubleshootFilesDevice{{
+			ID: d.Get("device").(string),
+		}},
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to generate troubleshoot files",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	taskID := res.Metadata.Task.ID
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		task, err := c.GetFmcTaskStatus(ctx, taskID)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		switch strings.ToUpper(task.Status) {
+		case "FAILED":
+			return resource.NonRetryableError(fmt.Errorf("generating troubleshoot files failed: %s", task.Message))
+		case "SUCCESS", "COMPLETED":
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("troubleshoot files are not generated yet, task status: %s", task.Status))
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to generate troubleshoot files",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if outputPath := d.Get("output_path").(string); outputPath != "" {
+		if err := downloadFmcTroubleshootFiles(ctx, c, taskID, outputPath); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to download troubleshoot files",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	d.SetId(taskID)
+	if err := d.Set("task_id", taskID); err != nil {
+		return returnWithDiag(diags, err)
+	}
+	return resourceFmcTroubleshootFilesRead(ctx, d, m)
+}
+
+// downloadFmcTroubleshootFiles writes the troubleshoot files to the output path, creating its directory if missing
+func downloadFmcTroubleshootFiles(ctx context.Context, c *Client, taskID, outputPath string) error {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	if err := c.DownloadFmcTroubleshootFiles(ctx, taskID, file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func resourceFmcTroubleshootFilesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// Generate the files again if the download was removed
+	if outputPath := d.Get("output_path").(string); outputPath != "" {
+		if _, err := os.Stat(outputPath); os.IsNotExist(err) {
+			d.SetId("")
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcTroubleshootFilesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	// The troubleshoot files cannot be deleted through the API, so only remove this resource from the state
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,71 @@
+package fmc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcTroubleshootFilesBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+	outputPath := filepath.Join(t.TempDir(), "troubleshoot.tar.gz")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcTroubleshootFilesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcTroubleshootFilesConfigBasic(device, outputPath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcTroubleshootFilesExists("fmc_troubleshoot_files.test", outputPath),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcTroubleshootFilesDestroy(s *terraform.State) error {
+	// Troubleshoot files stay in FMC, there is nothing to check
+	return nil
+}
+
+func testAccCheckFmcTroubleshootFilesConfigBasic(device, outputPath string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "test" {
+        name = "%s"
+    }
+    resource "fmc_troubleshoot_files" "test" {
+        device = data.fmc_devices.test.id
+        output_path = "%s"
+    }
+    `, device, outputPath)
+}
+
+func testAccCheckFmcTroubleshootFilesExists(n, outputPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		info, err := os.Stat(outputPath)
+		if err != nil {
+			return fmt.Errorf("troubleshoot files not downloaded: %s", err.Error())
+		}
+		if info.Size() == 0 {
+			return fmt.Errorf("troubleshoot files are empty")
+		}
+
+		return nil
+	}
+}
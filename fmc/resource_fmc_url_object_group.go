@@ -39,9 +39,10 @@ func resourceFmcURLObjectGroup() *schema.Resource {
 		DeleteContext: resourceFmcURLObjectGroupDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -55,13 +56,7 @@ func resourceFmcURLObjectGroup() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -140,7 +135,7 @@ func resourceFmcURLObjectGroupCreate(ctx context.Context, d *schema.ResourceData
 	}
 	res, err := c.CreateFmcURLObjectGroup(ctx, &URLObjectGroup{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Type:        url_object_group_type,
 		Objects:     objs,
 		Literals:    lits,
@@ -267,7 +262,7 @@ func resourceFmcURLObjectGroupUpdate(ctx context.Context, d *schema.ResourceData
 		_, err := c.UpdateFmcURLObjectGroup(ctx, id, &URLObjectGroupUpdateInput{
 			ID:          d.Id(),
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Type:        url_object_group_type,
 			Objects:     objs,
 			Literals:    lits,
@@ -294,7 +289,9 @@ func resourceFmcURLObjectGroupDelete(ctx context.Context, d *schema.ResourceData
 	id := d.Id()
 
 	err := c.DeleteFmcURLObjectGroup(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("url object group", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete url object group",
@@ -28,9 +28,10 @@ func resourceFmcURLObjects() *schema.Resource {
 		DeleteContext: resourceFmcURLObjectsDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of this resource",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFmcName(fmc_object_name_max_length),
+				Description:  "The name of this resource",
 			},
 			"url": {
 				Type:        schema.TypeString,
@@ -49,13 +50,7 @@ func resourceFmcURLObjects() *schema.Resource {
 					}
 					return state
 				},
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					// Fix for bug in the FMC API which returns " " for empty description
-					if (new == " " && old == "") || (old == " " && new == "") {
-						return true
-					}
-					return old == new
-				},
+				DiffSuppressFunc: suppressDescriptionMarker,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -74,7 +69,7 @@ func resourceFmcURLObjectsCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := c.CreateFmcURLObject(ctx, &URLObject{
 		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
+		Description: c.withDescriptionMarker(d),
 		Url:         d.Get("url").(string),
 		Type:        url_type,
 	})
@@ -152,7 +147,7 @@ func resourceFmcURLObjectsUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChanges("name", "description", "url") {
 		_, err := c.UpdateFmcURLObject(ctx, id, &URLObjectUpdateInput{
 			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
+			Description: c.withDescriptionMarker(d),
 			Url:         d.Get("url").(string),
 			Type:        url_type,
 			ID:          id,
@@ -178,7 +173,9 @@ func resourceFmcURLObjectsDelete(ctx context.Context, d *schema.ResourceData, m
 	id := d.Id()
 
 	err := c.DeleteFmcURLObject(ctx, id)
-	if err != nil {
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("url object", err))
+	} else if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "unable to delete url object",
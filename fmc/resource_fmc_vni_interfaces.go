@@ -0,0 +1,340 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var vni_interface_type string = "VNIInterface"
+
+func resourceFmcVNIInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for VNI Interfaces on FTD devices in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_vni_interfaces\" \"vni\" {\n" +
+			"    device = data.fmc_devices.ftd.id\n" +
+			"    vni_id = 1\n" +
+			"    vtep_id = fmc_vtep_policies.vtep.vtep_entry[0].nve_vtep_id\n" +
+			"    ifname = \"vni1\"\n" +
+			"    enable_proxy = true\n" +
+			"    security_zone {\n" +
+			"        id = data.fmc_security_zones.inside.id\n" +
+			"        type = data.fmc_security_zones.inside.type\n" +
+			"    }\n" +
+			"    ipv4_static_address = \"10.10.10.1\"\n" +
+			"    ipv4_static_netmask = \"24\"\n" +
+			"    ipv6_static_address = \"2001:db8::1\"\n" +
+			"    ipv6_static_prefix = \"64\"\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Set `enable_proxy` to use the VNI interface as a single-arm Geneve proxy behind an AWS Gateway Load Balancer. " +
+			"VNI interfaces are supported from FMC 7.0 onwards.",
+		CreateContext: resourceFmcVNIInterfacesCreate,
+		ReadContext:   resourceFmcVNIInterfacesRead,
+		UpdateContext: resourceFmcVNIInterfacesUpdate,
+		DeleteContext: resourceFmcVNIInterfacesDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return m.(*Client).requireFmcVersion(ctx, "fmc_vni_interfaces", vxlan_minimum_version)
+		},
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD device this resource belongs to",
+			},
+			"vni_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v >= 1 && v <= 10000 {
+						return
+					}
+					errs = append(errs, fmt.Errorf("%q must be between 1 and 10000, got: %d", key, v))
+					return
+				},
+				Description: "The VNI ID of this resource, between 1 and 10000",
+			},
+			"vtep_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "The NVE VTEP ID this resource is attached to",
+			},
+			"segment_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(int)
+					if v >= 1 && v <= 16777215 {
+						return
+					}
+					errs = append(errs, fmt.Errorf("%q must be between 1 and 16777215, got: %d", key, v))
+					return
+				},
+				Description: "The VXLAN segment ID of this resource, between 1 and 16777215",
+			},
+			"multicast_group_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The multicast group address of this resource",
+			},
+			"enable_proxy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable single-arm proxy for Geneve encapsulation (AWS Gateway Load Balancer)",
+			},
+			"ifname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The logical name of this resource",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDescriptionMarker,
+				Description:      "The description of this resource",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable this resource",
+			},
+			"security_zone": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of this resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of this resource",
+						},
+					},
+				},
+				Description: "Security zone for this resource",
+			},
+			"ipv4_static_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Static IPv4 address for this resource",
+			},
+			"ipv4_static_netmask": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Static IPv4 netmask for this resource",
+			},
+			"ipv6_static_address": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentIPValues,
+				Description:      "Static IPv6 address for this resource",
+			},
+			"ipv6_static_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Static IPv6 prefix length for this resource",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandVNIInterface(c *Client, d *schema.ResourceData) *VNIInterface {
+	var securityZone *VNIInterfaceSubConfig
+	if zones := d.Get("security_zone").([]interface{}); len(zones) > 0 {
+		zone := zones[0].(map[string]interface{})
+		securityZone = &VNIInterfaceSubConfig{
+			ID:   zone["id"].(string),
+			Type: zone["type"].(string),
+		}
+	}
+	var ipv4 *VNIInterfaceIPv4
+	if address, ok := d.GetOk("ipv4_static_address"); ok {
+		ipv4 = &VNIInterfaceIPv4{
+			Static: &VNIInterfaceIPv4Static{
+				Address: address.(string),
+				Netmask: d.Get("ipv4_static_netmask").(string),
+			},
+		}
+	}
+	var ipv6 *VNIInterfaceIPv6
+	if address, ok := d.GetOk("ipv6_static_address"); ok {
+		ipv6 = &VNIInterfaceIPv6{
+			Enableipv6: true,
+			Addresses: []VNIInterfaceIPv6Address{{
+				Address: address.(string),
+				Prefix:  d.Get("ipv6_static_prefix").(string),
+			}},
+		}
+	}
+	return &VNIInterface{
+		Type:                  vni_interface_type,
+		Ifname:                d.Get("ifname").(string),
+		Description:           c.withDescriptionMarker(d),
+		Enabled:               d.Get("enabled").(bool),
+		Vniid:                 d.Get("vni_id").(int),
+		Vtepid:                d.Get("vtep_id").(int),
+		Segmentid:             d.Get("segment_id").(int),
+		Multicastgroupaddress: d.Get("multicast_group_address").(string),
+		Enableproxy:           d.Get("enable_proxy").(bool),
+		Securityzone:          securityZone,
+		Ipv4:                  ipv4,
+		Ipv6:                  ipv6,
+	}
+}
+
+func resourceFmcVNIInterfacesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	if err := c.requireFmcVersion(ctx, "fmc_vni_interfaces", vxlan_minimum_version); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create vni interface",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	res, err := c.CreateFmcVNIInterface(ctx, d.Get("device").(string), expandVNIInterface(c, d))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create vni interface",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcVNIInterfacesRead(ctx, d, m)
+}
+
+func resourceFmcVNIInterfacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcVNIInterface(ctx, d.Get("device").(string), d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read vni interface",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	securityZone := make([]interface{}, 0)
+	if item.Securityzone.ID != "" {
+		securityZone = append(securityZone, map[string]interface{}{
+			"id":   item.Securityzone.ID,
+			"type": item.Securityzone.Type,
+		})
+	}
+
+	ipv6Address, ipv6Prefix := "", ""
+	if len(item.Ipv6.Addresses) > 0 {
+		ipv6Address, ipv6Prefix = item.Ipv6.Addresses[0].Address, item.Ipv6.Addresses[0].Prefix
+	}
+
+	for key, value := range map[string]interface{}{
+		"name":                    item.Name,
+		"type":                    item.Type,
+		"ifname":                  item.Ifname,
+		"description":             item.Description,
+		"enabled":                 item.Enabled,
+		"vni_id":                  item.Vniid,
+		"vtep_id":                 item.Vtepid,
+		"segment_id":              item.Segmentid,
+		"multicast_group_address": item.Multicastgroupaddress,
+		"enable_proxy":            item.Enableproxy,
+		"security_zone":           securityZone,
+		"ipv4_static_address":     item.Ipv4.Static.Address,
+		"ipv4_static_netmask":     item.Ipv4.Static.Netmask,
+		"ipv6_static_address":     ipv6Address,
+		"ipv6_static_prefix":      ipv6Prefix,
+	} {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to read vni interface",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFmcVNIInterfacesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("vtep_id", "segment_id", "multicast_group_address", "enable_proxy", "ifname", "description", "enabled", "security_zone", "ipv4_static_address", "ipv4_static_netmask", "ipv6_static_address", "ipv6_static_prefix") {
+		object := expandVNIInterface(c, d)
+		object.ID = d.Id()
+		object.Name = d.Get("name").(string)
+		_, err := c.UpdateFmcVNIInterface(ctx, d.Get("device").(string), d.Id(), object)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update vni interface",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcVNIInterfacesRead(ctx, d, m)
+}
+
+func resourceFmcVNIInterfacesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcVNIInterface(ctx, d.Get("device").(string), d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("vni interface", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete vni interface",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,118 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcVNIInterfaceBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+	sourceInterface := "GigabitEthernet0/0"
+	ifname := "vni1"
+	description := "test vni interface"
+	descriptionUpdated := "test vni interface updated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcVNIInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcVNIInterfaceConfigBasic(device, sourceInterface, ifname, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcVNIInterfaceExists("fmc_vni_interfaces.test", map[string]string{
+						"ifname":      ifname,
+						"description": description,
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcVNIInterfaceConfigBasic(device, sourceInterface, ifname, descriptionUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcVNIInterfaceExists("fmc_vni_interfaces.test", map[string]string{
+						"ifname":      ifname,
+						"description": descriptionUpdated,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcVNIInterfaceDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_vni_interfaces" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcVNIInterface(ctx, rs.Primary.Attributes["device"], id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcVNIInterfaceConfigBasic(device, sourceInterface, ifname, description string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "device" {
+        name = "%s"
+    }
+    data "fmc_device_physical_interfaces" "source" {
+        device = data.fmc_devices.device.id
+        name   = "%s"
+    }
+    resource "fmc_vtep_policies" "vtep" {
+        device = data.fmc_devices.device.id
+        vtep_entry {
+            source_interface {
+                id   = data.fmc_device_physical_interfaces.source.id
+                type = data.fmc_device_physical_interfaces.source.type
+            }
+            nve_encapsulation_type = "GENEVE"
+        }
+    }
+    resource "fmc_vni_interfaces" "test" {
+        device       = data.fmc_devices.device.id
+        vni_id       = 1
+        vtep_id      = fmc_vtep_policies.vtep.vtep_entry[0].nve_vtep_id
+        ifname       = "%s"
+        description  = "%s"
+        enable_proxy = true
+    }
+    `, device, sourceInterface, ifname, description)
+}
+
+func testAccCheckFmcVNIInterfaceExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
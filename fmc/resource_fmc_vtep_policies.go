@@ -0,0 +1,358 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var vtep_policy_type string = "VTEPPolicy"
+
+// The endpoints of the VTEP policies and VNI interfaces were added in FMC 7.0
+var vxlan_minimum_version string = "7.0"
+
+// Default NVE destination ports, VXLAN uses 4789 and Geneve (AWS GWLB) uses 6081
+var vtep_default_ports = map[string]int{
+	"VXLAN":  4789,
+	"GENEVE": 6081,
+}
+
+func resourceFmcVTEPPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resource for VTEP Policies on FTD devices in FMC\n" +
+			"\n" +
+			"## Example\n" +
+			"An example is shown below: \n" +
+			"```hcl\n" +
+			"resource \"fmc_vtep_policies\" \"vtep\" {\n" +
+			"    device = data.fmc_devices.ftd.id\n" +
+			"    nve_enable = true\n" +
+			"    vtep_entry {\n" +
+			"        source_interface {\n" +
+			"            id = data.fmc_device_physical_interfaces.outside.id\n" +
+			"            type = data.fmc_device_physical_interfaces.outside.type\n" +
+			"        }\n" +
+			"        nve_vtep_id = 1\n" +
+			"        nve_encapsulation_type = \"GENEVE\"\n" +
+			"    }\n" +
+			"}\n" +
+			"```\n" +
+			"**Note** Use `GENEVE` encapsulation for FTDv deployments behind an AWS Gateway Load Balancer. The destination port defaults to 4789 for VXLAN and 6081 for Geneve. " +
+			"VTEP policies are supported from FMC 7.0 onwards.",
+		CreateContext: resourceFmcVTEPPoliciesCreate,
+		ReadContext:   resourceFmcVTEPPoliciesRead,
+		UpdateContext: resourceFmcVTEPPoliciesUpdate,
+		DeleteContext: resourceFmcVTEPPoliciesDelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return m.(*Client).requireFmcVersion(ctx, "fmc_vtep_policies", vxlan_minimum_version)
+		},
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the FTD device this resource belongs to",
+			},
+			"nve_enable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable the network virtualization endpoint (NVE) for this resource",
+			},
+			"vtep_entry": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_interface": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+							Description: "Source interface of the VTEP",
+						},
+						"nve_vtep_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "The NVE VTEP ID",
+						},
+						"nve_destination_port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The NVE destination port, defaults to 4789 for VXLAN and 6081 for GENEVE",
+						},
+						"nve_encapsulation_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "VXLAN",
+							StateFunc: func(val interface{}) string {
+								return strings.ToUpper(val.(string))
+							},
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := strings.ToUpper(val.(string))
+								allowedValues := []string{"VXLAN", "GENEVE"}
+								for _, allowed := range allowedValues {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+								return
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+							Description: `The NVE encapsulation type, "VXLAN" or "GENEVE"`,
+						},
+						"nve_neighbor_discovery_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							StateFunc: func(val interface{}) string {
+								return strings.ToUpper(val.(string))
+							},
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								v := strings.ToUpper(val.(string))
+								allowedValues := []string{"NONE", "STATIC_PEER_IP", "PEER_GROUP", "DEFAULT_MULTICAST_GROUP"}
+								for _, allowed := range allowedValues {
+									if v == allowed {
+										return
+									}
+								}
+								errs = append(errs, fmt.Errorf("%q must be in %v, got: %q", key, allowedValues, v))
+								return
+							},
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+							Description: `The NVE neighbor discovery type, "NONE", "STATIC_PEER_IP", "PEER_GROUP" or "DEFAULT_MULTICAST_GROUP"`,
+						},
+						"nve_neighbor_address": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of this resource",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of this resource",
+									},
+								},
+							},
+							Description: "Network object for the NVE peer or peer group",
+						},
+					},
+				},
+				Description: "VTEP entry for this resource",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this resource",
+			},
+		},
+	}
+}
+
+func expandVTEPEntries(d *schema.ResourceData) []VTEPEntry {
+	var entries []VTEPEntry
+	for _, ent := range d.Get("vtep_entry").([]interface{}) {
+		entry := ent.(map[string]interface{})
+		sourceInterface := entry["source_interface"].([]interface{})[0].(map[string]interface{})
+		encapsulationType := strings.ToUpper(entry["nve_encapsulation_type"].(string))
+		destinationPort := entry["nve_destination_port"].(int)
+		if destinationPort == 0 {
+			destinationPort = vtep_default_ports[encapsulationType]
+		}
+		var neighborAddress *VTEPPolicySubConfig
+		if addrs := entry["nve_neighbor_address"].([]interface{}); len(addrs) > 0 {
+			addr := addrs[0].(map[string]interface{})
+			neighborAddress = &VTEPPolicySubConfig{
+				ID:   addr["id"].(string),
+				Type: addr["type"].(string),
+			}
+		}
+		entries = append(entries, VTEPEntry{
+			Sourceinterface: VTEPPolicySubConfig{
+				ID:   sourceInterface["id"].(string),
+				Type: sourceInterface["type"].(string),
+			},
+			Nvevtepid:                entry["nve_vtep_id"].(int),
+			Nvedestinationport:       destinationPort,
+			Nveencapsulationtype:     encapsulationType,
+			Nveneighbordiscoverytype: strings.ToUpper(entry["nve_neighbor_discovery_type"].(string)),
+			Nveneighboraddress:       neighborAddress,
+		})
+	}
+	return entries
+}
+
+func resourceFmcVTEPPoliciesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	// Warning or errors can be collected in a slice type
+	// var diags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	if err := c.requireFmcVersion(ctx, "fmc_vtep_policies", vxlan_minimum_version); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create vtep policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	res, err := c.CreateFmcVTEPPolicy(ctx, d.Get("device").(string), &VTEPPolicy{
+		Type:        vtep_policy_type,
+		Nveenable:   d.Get("nve_enable").(bool),
+		Vtepentries: expandVTEPEntries(d),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to create vtep policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	d.SetId(res.ID)
+	return resourceFmcVTEPPoliciesRead(ctx, d, m)
+}
+
+func resourceFmcVTEPPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	item, err := c.GetFmcVTEPPolicy(ctx, d.Get("device").(string), d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read vtep policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("type", item.Type); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read vtep policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	if err := d.Set("nve_enable", item.Nveenable); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read vtep policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	entries := make([]interface{}, 0, len(item.Vtepentries))
+	for _, entry := range item.Vtepentries {
+		neighborAddress := make([]interface{}, 0)
+		if entry.Nveneighboraddress.ID != "" {
+			neighborAddress = append(neighborAddress, map[string]interface{}{
+				"id":   entry.Nveneighboraddress.ID,
+				"type": entry.Nveneighboraddress.Type,
+			})
+		}
+		entries = append(entries, map[string]interface{}{
+			"source_interface": []interface{}{map[string]interface{}{
+				"id":   entry.Sourceinterface.ID,
+				"type": entry.Sourceinterface.Type,
+			}},
+			"nve_vtep_id":                 entry.Nvevtepid,
+			"nve_destination_port":        entry.Nvedestinationport,
+			"nve_encapsulation_type":      entry.Nveencapsulationtype,
+			"nve_neighbor_discovery_type": entry.Nveneighbordiscoverytype,
+			"nve_neighbor_address":        neighborAddress,
+		})
+	}
+	if err := d.Set("vtep_entry", entries); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to read vtep policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceFmcVTEPPoliciesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+	var diags diag.Diagnostics
+	if d.HasChanges("nve_enable", "vtep_entry") {
+		_, err := c.UpdateFmcVTEPPolicy(ctx, d.Get("device").(string), d.Id(), &VTEPPolicy{
+			ID:          d.Id(),
+			Type:        vtep_policy_type,
+			Nveenable:   d.Get("nve_enable").(bool),
+			Vtepentries: expandVTEPEntries(d),
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "unable to update vtep policy",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+	return resourceFmcVTEPPoliciesRead(ctx, d, m)
+}
+
+func resourceFmcVTEPPoliciesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := c.DeleteFmcVTEPPolicy(ctx, d.Get("device").(string), d.Id())
+	if isNotFound(err) {
+		diags = append(diags, alreadyDeleted("vtep policy", err))
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "unable to delete vtep policy",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,106 @@
+package fmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFmcVTEPPolicyBasic(t *testing.T) {
+	device := "ftd.adyah.cisco"
+	sourceInterface := "GigabitEthernet0/0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmcVTEPPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckFmcVTEPPolicyConfigBasic(device, sourceInterface, "VXLAN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcVTEPPolicyExists("fmc_vtep_policies.test", map[string]string{
+						"vtep_entry.0.nve_encapsulation_type": "VXLAN",
+						"vtep_entry.0.nve_destination_port":   "4789",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckFmcVTEPPolicyConfigBasic(device, sourceInterface, "GENEVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmcVTEPPolicyExists("fmc_vtep_policies.test", map[string]string{
+						"vtep_entry.0.nve_encapsulation_type": "GENEVE",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmcVTEPPolicyDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fmc_vtep_policies" {
+			continue
+		}
+
+		id := rs.Primary.ID
+		ctx := context.Background()
+		err := c.DeleteFmcVTEPPolicy(ctx, rs.Primary.Attributes["device"], id)
+
+		// Object is already deleted
+		if err != nil && !strings.Contains(fmt.Sprint(err), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFmcVTEPPolicyConfigBasic(device, sourceInterface, encapsulationType string) string {
+	return fmt.Sprintf(`
+    data "fmc_devices" "device" {
+        name = "%s"
+    }
+    data "fmc_device_physical_interfaces" "source" {
+        device = data.fmc_devices.device.id
+        name   = "%s"
+    }
+    resource "fmc_vtep_policies" "test" {
+        device = data.fmc_devices.device.id
+        vtep_entry {
+            source_interface {
+                id   = data.fmc_device_physical_interfaces.source.id
+                type = data.fmc_device_physical_interfaces.source.type
+            }
+            nve_encapsulation_type = "%s"
+        }
+    }
+    `, device, sourceInterface, encapsulationType)
+}
+
+func testAccCheckFmcVTEPPolicyExists(n string, properties map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID set")
+		}
+
+		for key, value := range properties {
+			if rs.Primary.Attributes[key] != value {
+				return fmt.Errorf("attribute mismatch for key: %s. Expected: %s, got: %s", key, value, rs.Primary.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
@@ -1,5 +1,7 @@
 package fmc
 
+import "context"
+
 type semaphore chan struct{}
 
 func Semaphore(n int) *semaphore {
@@ -28,6 +30,16 @@ func (s semaphore) Lock() {
 	s.P(1)
 }
 
+// LockContext locks unless the context is cancelled first
+func (s semaphore) LockContext(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (s semaphore) Unlock() {
 	s.V(1)
 }
@@ -3,6 +3,10 @@ package fmc
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
 	"time"
 )
 
@@ -13,3 +17,12 @@ func randomString(length int) string {
 	rand.Read(b)
 	return fmt.Sprintf("%x", b)[:length]
 }
+
+// newTestClient returns a client of a test FMC served by the handler, the server is closed when the test ends
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	c := NewClient("admin", "password", strings.TrimPrefix(server.URL, "https://"), true)
+	c.domainBaseURL = server.URL + "/api/fmc_config/v1/domain/default"
+	return c, server
+}
@@ -13,4 +13,7 @@ func main() {
 			return fmc.Provider()
 		},
 	})
+	// Serve returns when terraform stops the provider at the end of the run, the statistics are also logged
+	// periodically while the provider runs since terraform may not read this anymore
+	fmc.LogAPIStatistics()
 }